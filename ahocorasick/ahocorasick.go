@@ -0,0 +1,329 @@
+package ahocorasick
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+var (
+	ErrNoKeywords     = errors.New("error no keywords")
+	ErrEmptyKeyword   = errors.New("error empty keyword")
+	ErrInvalidKeyword = errors.New("error keyword is not valid UTF-8")
+)
+
+// root is the index of the node of the empty prefix.
+const root = 0
+
+// Options configures a Matcher.
+type Options struct {
+	// DFA compiles the failure links into a full transition table, so every rune takes
+	// exactly one transition. Matching is faster, construction uses more memory.
+	DFA bool
+}
+
+// Match is an occurrence of a keyword in the text.
+type Match struct {
+	// ID is the index of the keyword in the dictionary.
+	ID int
+
+	// Start is the byte offset of the first rune.
+	Start int
+
+	// End is the byte offset after the last rune.
+	End int
+}
+
+// node is a prefix of the keywords.
+type node struct {
+	// children maps runes to the nodes extending the prefix with them.
+	children map[rune]int
+
+	// fail is the node of the longest proper suffix of the prefix that is also a prefix.
+	fail int
+
+	// dict is the node of the longest proper suffix of the prefix that is a keyword, -1 if there is none.
+	dict int
+
+	// output contains the IDs of the keywords equal to the prefix.
+	output []int
+
+	// delta maps runes to the next node when compiled to a DFA, runes leading to the root are omitted.
+	delta map[rune]int
+}
+
+// Matcher finds all occurrences of a dictionary of keywords in a single pass over the text,
+// using a trie of the keywords with failure links.
+type Matcher struct {
+	// keywords contains the dictionary, keywords are identified by their index.
+	keywords []string
+
+	// nodes contains the trie, the root first.
+	nodes []node
+
+	// alphabet contains the runes of the keywords in ascending order.
+	alphabet []rune
+
+	// dfa is true if the nodes have a full transition table.
+	dfa bool
+}
+
+// New builds a matcher for the keywords, which must be valid UTF-8. The ID of a keyword is its index,
+// duplicate keywords are reported with every ID.
+func New(keywords []string, options Options) (*Matcher, error) {
+	if len(keywords) == 0 {
+		return nil, ErrNoKeywords
+	}
+
+	m := &Matcher{
+		keywords: slices.Clone(keywords),
+		nodes:    []node{newNode()},
+		alphabet: make([]rune, 0),
+		dfa:      options.DFA,
+	}
+
+	seen := make(map[rune]bool)
+	for id, keyword := range keywords {
+		if keyword == "" {
+			return nil, fmt.Errorf("%w - at index %d", ErrEmptyKeyword, id)
+		}
+		if !utf8.ValidString(keyword) {
+			return nil, fmt.Errorf("%w - %q at index %d", ErrInvalidKeyword, keyword, id)
+		}
+
+		q := root
+		for _, r := range keyword {
+			if !seen[r] {
+				seen[r] = true
+				m.alphabet = append(m.alphabet, r)
+			}
+
+			next, ok := m.nodes[q].children[r]
+			if !ok {
+				next = len(m.nodes)
+				m.nodes = append(m.nodes, newNode())
+				m.nodes[q].children[r] = next
+			}
+			q = next
+		}
+		m.nodes[q].output = append(m.nodes[q].output, id)
+	}
+	slices.Sort(m.alphabet)
+
+	m.link()
+	if m.dfa {
+		m.compile()
+	}
+
+	return m, nil
+}
+
+// newNode returns a node without children and keywords.
+func newNode() node {
+	return node{children: make(map[rune]int), dict: -1}
+}
+
+// link sets the failure and dictionary links in breadth-first order, so the links
+// of shorter prefixes are set first.
+func (m *Matcher) link() {
+	queue := []int{root}
+	for i := 0; i < len(queue); i++ {
+		q := queue[i]
+		for _, r := range m.runes(q) {
+			child := m.nodes[q].children[r]
+			queue = append(queue, child)
+			if q == root {
+				continue
+			}
+
+			fail := m.nodes[q].fail
+			for fail != root {
+				if _, ok := m.nodes[fail].children[r]; ok {
+					break
+				}
+				fail = m.nodes[fail].fail
+			}
+			if next, ok := m.nodes[fail].children[r]; ok {
+				fail = next
+			}
+
+			m.nodes[child].fail = fail
+			if len(m.nodes[fail].output) > 0 {
+				m.nodes[child].dict = fail
+			} else {
+				m.nodes[child].dict = m.nodes[fail].dict
+			}
+		}
+	}
+}
+
+// compile sets the full transition table of every node in breadth-first order, starting
+// from the table of its failure node.
+func (m *Matcher) compile() {
+	queue := []int{root}
+	for i := 0; i < len(queue); i++ {
+		q := queue[i]
+		if q == root {
+			m.nodes[q].delta = make(map[rune]int, len(m.nodes[q].children))
+		} else {
+			m.nodes[q].delta = make(map[rune]int, len(m.nodes[m.nodes[q].fail].delta)+len(m.nodes[q].children))
+			for r, next := range m.nodes[m.nodes[q].fail].delta {
+				m.nodes[q].delta[r] = next
+			}
+		}
+
+		for _, r := range m.runes(q) {
+			child := m.nodes[q].children[r]
+			m.nodes[q].delta[r] = child
+			queue = append(queue, child)
+		}
+	}
+}
+
+// runes returns the runes of the children of the node in ascending order.
+func (m *Matcher) runes(q int) []rune {
+	runes := make([]rune, 0, len(m.nodes[q].children))
+	for r := range m.nodes[q].children {
+		runes = append(runes, r)
+	}
+	slices.Sort(runes)
+
+	return runes
+}
+
+// Keywords returns the dictionary.
+func (m *Matcher) Keywords() []string {
+	return slices.Clone(m.keywords)
+}
+
+// FindAll returns every occurrence of the keywords in the text, including overlapping ones,
+// ordered by end, then from the longest to the shortest keyword. Bytes that aren't valid UTF-8
+// are never part of an occurrence.
+func (m *Matcher) FindAll(text string) []Match {
+	matches := make([]Match, 0)
+	q := root
+	for end := 0; end < len(text); {
+		r, size := utf8.DecodeRuneInString(text[end:])
+		q = m.step(q, r, size)
+		end += size
+		for out := q; out >= 0; out = m.nodes[out].dict {
+			for _, id := range m.nodes[out].output {
+				matches = append(matches, Match{ID: id, Start: end - len(m.keywords[id]), End: end})
+			}
+		}
+	}
+
+	return matches
+}
+
+// Contains returns true if the text contains one of the keywords.
+func (m *Matcher) Contains(text string) bool {
+	q := root
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		q = m.step(q, r, size)
+		i += size
+		if len(m.nodes[q].output) > 0 || m.nodes[q].dict >= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// step returns the node reached from q with a rune of the text decoded from size bytes.
+// Keywords are valid UTF-8, so an invalid byte leads back to the root.
+func (m *Matcher) step(q int, r rune, size int) int {
+	if r == utf8.RuneError && size == 1 {
+		return root
+	}
+
+	return m.next(q, r)
+}
+
+// next returns the node reached from q with the rune.
+func (m *Matcher) next(q int, r rune) int {
+	if m.dfa {
+		if next, ok := m.nodes[q].delta[r]; ok {
+			return next
+		}
+		return root
+	}
+
+	for {
+		if next, ok := m.nodes[q].children[r]; ok {
+			return next
+		}
+		if q == root {
+			return root
+		}
+		q = m.nodes[q].fail
+	}
+}
+
+// Automaton returns the matcher as a FiniteAutomation over the runes of the keywords, following the
+// failure links so every state has a transition for every rune. Runes leading back to the initial state
+// use the default transition, and final states are the ones recognizing a keyword, see Output.
+// States are named after the trie nodes, N0 for the empty prefix.
+func (m *Matcher) Automaton() *automaton.FiniteAutomation {
+	names := make([]string, len(m.nodes))
+	finals := make([]string, 0)
+	for q := range m.nodes {
+		names[q] = "N" + strconv.Itoa(q)
+		if len(m.nodes[q].output) > 0 || m.nodes[q].dict >= 0 {
+			finals = append(finals, names[q])
+		}
+	}
+
+	transitions := make(transition.Transitions, 0)
+	for q := range m.nodes {
+		for _, r := range m.alphabet {
+			if next := m.next(q, r); next != root {
+				transitions = append(transitions, transition.Transition{
+					StartState:  names[q],
+					Input:       string(r),
+					ResultState: names[next],
+				})
+			}
+		}
+	}
+
+	// Names are unique and transitions only use them, so the construction can't fail.
+	fa, _ := automaton.NewFiniteAutomation(names, names[root], finals, transitions)
+	fa.TransitionInputs = make([]string, len(m.alphabet))
+	for i, r := range m.alphabet {
+		fa.TransitionInputs[i] = string(r)
+	}
+	for _, state := range fa.States {
+		state.SetDefault(fa.States[root])
+	}
+
+	return fa
+}
+
+// Output returns the IDs of the keywords recognized when reaching a state of the automation,
+// from the longest to the shortest keyword.
+func (m *Matcher) Output(state string) []int {
+	index, ok := strings.CutPrefix(state, "N")
+	if !ok {
+		return nil
+	}
+
+	q, err := strconv.Atoi(index)
+	if err != nil || q < 0 || q >= len(m.nodes) {
+		return nil
+	}
+
+	ids := make([]int, 0)
+	for out := q; out >= 0; out = m.nodes[out].dict {
+		ids = append(ids, m.nodes[out].output...)
+	}
+
+	return ids
+}
@@ -0,0 +1,181 @@
+package ahocorasick_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/ahocorasick"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newClassic builds a matcher for the dictionary of the original Aho–Corasick paper.
+func newClassic(t *testing.T, options ahocorasick.Options) *ahocorasick.Matcher {
+	t.Helper()
+
+	m, err := ahocorasick.New([]string{"he", "she", "his", "hers"}, options)
+	require.NoError(t, err)
+
+	return m
+}
+
+// naive returns every occurrence of the keywords, ordered by end then from the longest keyword.
+func naive(keywords []string, text string) []ahocorasick.Match {
+	matches := make([]ahocorasick.Match, 0)
+	for end := 1; end <= len(text); end++ {
+		for length := end; length > 0; length-- {
+			for id, keyword := range keywords {
+				if len(keyword) == length && text[end-length:end] == keyword {
+					matches = append(matches, ahocorasick.Match{ID: id, Start: end - length, End: end})
+				}
+			}
+		}
+	}
+
+	return matches
+}
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m := newClassic(t, ahocorasick.Options{})
+
+		assert.Equal(t, []string{"he", "she", "his", "hers"}, m.Keywords())
+	})
+
+	t.Run("no keywords", func(t *testing.T) {
+		_, err := ahocorasick.New(nil, ahocorasick.Options{})
+
+		assert.ErrorIs(t, err, ahocorasick.ErrNoKeywords)
+	})
+
+	t.Run("empty keyword", func(t *testing.T) {
+		_, err := ahocorasick.New([]string{"a", ""}, ahocorasick.Options{})
+
+		assert.ErrorIs(t, err, ahocorasick.ErrEmptyKeyword)
+	})
+
+	t.Run("invalid keyword", func(t *testing.T) {
+		_, err := ahocorasick.New([]string{"a", "\xff"}, ahocorasick.Options{})
+
+		assert.ErrorIs(t, err, ahocorasick.ErrInvalidKeyword)
+	})
+}
+
+func TestMatcher_FindAll(t *testing.T) {
+	for name, options := range map[string]ahocorasick.Options{"trie": {}, "dfa": {DFA: true}} {
+		t.Run(name, func(t *testing.T) {
+			m := newClassic(t, options)
+
+			assert.Equal(t, []ahocorasick.Match{
+				{ID: 1, Start: 1, End: 4},
+				{ID: 0, Start: 2, End: 4},
+				{ID: 3, Start: 2, End: 6},
+			}, m.FindAll("ushers"))
+			assert.Empty(t, m.FindAll("hxs"))
+		})
+	}
+
+	t.Run("unicode", func(t *testing.T) {
+		m, err := ahocorasick.New([]string{"größe", "öß"}, ahocorasick.Options{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []ahocorasick.Match{
+			{ID: 1, Start: 3, End: 7},
+			{ID: 0, Start: 1, End: 8},
+		}, m.FindAll("xgröße"))
+	})
+
+	t.Run("invalid text", func(t *testing.T) {
+		for _, options := range []ahocorasick.Options{{}, {DFA: true}} {
+			m, err := ahocorasick.New([]string{"\uFFFD", "ab"}, options)
+			require.NoError(t, err)
+
+			// Invalid bytes are one byte wide and don't match the replacement character.
+			text := "\xffa\xffab\uFFFD"
+			matches := m.FindAll(text)
+			assert.Equal(t, []ahocorasick.Match{{ID: 1, Start: 3, End: 5}, {ID: 0, Start: 5, End: 8}}, matches)
+			for _, match := range matches {
+				assert.Equal(t, m.Keywords()[match.ID], text[match.Start:match.End])
+			}
+
+			assert.False(t, m.Contains("\xffx"))
+		}
+	})
+
+	t.Run("duplicates", func(t *testing.T) {
+		m, err := ahocorasick.New([]string{"ab", "ab"}, ahocorasick.Options{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []ahocorasick.Match{{ID: 0, Start: 0, End: 2}, {ID: 1, Start: 0, End: 2}}, m.FindAll("ab"))
+	})
+
+	t.Run("naive", func(t *testing.T) {
+		keywords := []string{"a", "ab", "bab", "bc", "bca", "c", "caa", "aaa"}
+		text := strings.Repeat("abccabaabcab", 3)
+
+		for _, options := range []ahocorasick.Options{{}, {DFA: true}} {
+			m, err := ahocorasick.New(keywords, options)
+			require.NoError(t, err)
+
+			assert.Equal(t, naive(keywords, text), m.FindAll(text))
+		}
+	})
+}
+
+func TestMatcher_Contains(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m := newClassic(t, ahocorasick.Options{DFA: true})
+
+		assert.True(t, m.Contains("this"))
+		assert.True(t, m.Contains("ashe"))
+		assert.False(t, m.Contains("hxs"))
+	})
+
+	t.Run("invalid text", func(t *testing.T) {
+		m, err := ahocorasick.New([]string{"a\uFFFDb"}, ahocorasick.Options{})
+		require.NoError(t, err)
+
+		assert.False(t, m.Contains("a\xffb"))
+		assert.True(t, m.Contains("\xffa\uFFFDb"))
+	})
+}
+
+func TestMatcher_Automaton(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m := newClassic(t, ahocorasick.Options{})
+
+		fa := m.Automaton()
+		assert.Len(t, fa.States, 10)
+		assert.Equal(t, "N0", fa.InitialState.GetName())
+		assert.Equal(t, []string{"e", "h", "i", "r", "s"}, fa.TransitionInputs)
+
+		// The automaton accepts the inputs ending with a keyword.
+		for _, text := range []string{"she", "hshe", "his", "hers", "sihe"} {
+			accepted, err := fa.Accepts(strings.Split(text, "")...)
+			require.NoError(t, err)
+			assert.True(t, accepted, text)
+		}
+		accepted, err := fa.Accepts(strings.Split("hershi", "")...)
+		require.NoError(t, err)
+		assert.False(t, accepted)
+
+		// Runes outside of the keywords take the default transition.
+		state, err := fa.ExecuteString("ushe")
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 0}, m.Output(state))
+	})
+
+	t.Run("same as dfa", func(t *testing.T) {
+		assert.Equal(t, newClassic(t, ahocorasick.Options{}).Automaton().Transitions(), newClassic(t, ahocorasick.Options{DFA: true}).Automaton().Transitions())
+	})
+}
+
+func TestMatcher_Output(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m := newClassic(t, ahocorasick.Options{})
+
+		assert.Empty(t, m.Output("N0"))
+		assert.Nil(t, m.Output("N99"))
+		assert.Nil(t, m.Output("S1"))
+	})
+}
@@ -1,28 +1,99 @@
 package builder
 
 import (
+	"slices"
+
 	"github.com/amitprajapati027/finite-automation/internal/automaton"
 	"github.com/amitprajapati027/finite-automation/internal/validation"
 	"github.com/amitprajapati027/finite-automation/transition"
 )
 
+// ConflictingTransitionError describes two transitions with the same start state and input
+// but a different result state. It wraps ErrConflictingTransition.
+type ConflictingTransitionError = validation.ConflictingTransitionError
+
+// ErrConflictingTransition is returned by strict validation when two transitions conflict.
+var ErrConflictingTransition = validation.ErrConflictingTransition
+
+// ValidationReport contains every problem found in a builder's configuration.
+type ValidationReport = validation.ValidationReport
+
+// Issue is a single problem found in a builder's configuration.
+type Issue = validation.Issue
+
+// Severity describes how serious an issue is.
+type Severity = validation.Severity
+
+const (
+	// SeverityWarning is a problem that doesn't prevent building the automaton.
+	SeverityWarning = validation.SeverityWarning
+
+	// SeverityError is a problem that prevents building the automaton.
+	SeverityError = validation.SeverityError
+)
+
 // AutomatonBuilder provides an interface for constructing finite automata.
 type AutomatonBuilder struct {
 	states       []string
 	initialState string
 	finalStates  []string
 	transitions  transition.Transitions
+
+	// alphabet contains the declared inputs, nil if the inputs are derived from the transitions.
+	alphabet []string
+
+	// defaults maps states to the target of their default transition.
+	defaults map[string]string
+
+	// rangeTransitions contains the transitions for ranges of runes.
+	rangeTransitions transition.RangeTransitions
+
+	// requireUsefulStates makes validation fail on unreachable or dead states.
+	requireUsefulStates bool
+
+	// strict makes validation fail on conflicting transitions.
+	strict bool
 }
 
 // NewAutomatonBuilder creates a new AutomatonBuilder.
 func NewAutomatonBuilder() *AutomatonBuilder {
 	return &AutomatonBuilder{
-		states:      make([]string, 0),
-		finalStates: make([]string, 0),
-		transitions: make(transition.Transitions, 0),
+		states:           make([]string, 0),
+		finalStates:      make([]string, 0),
+		transitions:      make(transition.Transitions, 0),
+		defaults:         make(map[string]string),
+		rangeTransitions: make(transition.RangeTransitions, 0),
 	}
 }
 
+// FromAutomaton creates a new AutomatonBuilder configured like the automaton,
+// so it can be modified and built again.
+func FromAutomaton(fa *automaton.FiniteAutomation) *AutomatonBuilder {
+	b := NewAutomatonBuilder()
+	for _, state := range fa.States {
+		b.AddState(state.GetName())
+
+		if state.IsFinal() {
+			b.AddFinalState(state.GetName())
+		}
+
+		if state.Default() != nil {
+			b.DefaultTransition(state.GetName(), state.Default().GetName())
+		}
+	}
+
+	b.InitialState(fa.InitialState.GetName())
+	b.Transitions(fa.Transitions()...)
+	b.RangeTransitions(fa.RangeTransitions()...)
+
+	// Only declare the alphabet if it differs from the inputs of the transitions.
+	if !slices.Equal(fa.TransitionInputs, b.transitions.GetInputs()) {
+		b.Alphabet(slices.Clone(fa.TransitionInputs)...)
+	}
+
+	return b
+}
+
 // States sets the states of the automaton.
 func (b *AutomatonBuilder) States(states ...string) *AutomatonBuilder {
 	b.states = states
@@ -65,9 +136,186 @@ func (b *AutomatonBuilder) AddTransition(transition transition.Transition) *Auto
 	return b
 }
 
+// RangeTransitions sets the range transitions of the automaton.
+func (b *AutomatonBuilder) RangeTransitions(transitions ...transition.RangeTransition) *AutomatonBuilder {
+	b.rangeTransitions = transitions
+	return b
+}
+
+// AddRangeTransition adds a transition taken for any rune of a set,
+// for example transition.Range('a', 'z') or transition.RuneSetOf(unicode.Letter).
+func (b *AutomatonBuilder) AddRangeTransition(transition transition.RangeTransition) *AutomatonBuilder {
+	b.rangeTransitions = append(b.rangeTransitions, transition)
+	return b
+}
+
+// RemoveState removes a state, along with its final state membership, its transitions and the
+// transitions leading to it. Removing the initial state leaves the initial state unset.
+func (b *AutomatonBuilder) RemoveState(state string) *AutomatonBuilder {
+	b.states = without(b.states, state)
+	b.finalStates = without(b.finalStates, state)
+	b.transitions = filterTransitions(b.transitions, func(t transition.Transition) bool {
+		return t.StartState != state && t.ResultState != state
+	})
+
+	rangeTransitions := make(transition.RangeTransitions, 0, len(b.rangeTransitions))
+	for _, t := range b.rangeTransitions {
+		if t.StartState != state && t.ResultState != state {
+			rangeTransitions = append(rangeTransitions, t)
+		}
+	}
+	b.rangeTransitions = rangeTransitions
+
+	for start, target := range b.defaults {
+		if start == state || target == state {
+			delete(b.defaults, start)
+		}
+	}
+
+	if b.initialState == state {
+		b.initialState = ""
+	}
+
+	return b
+}
+
+// RenameState renames a state and updates all references to it.
+func (b *AutomatonBuilder) RenameState(oldName, newName string) *AutomatonBuilder {
+	rename := func(state string) string {
+		if state == oldName {
+			return newName
+		}
+		return state
+	}
+
+	b.states = mapStates(b.states, rename)
+	b.finalStates = mapStates(b.finalStates, rename)
+	b.initialState = rename(b.initialState)
+
+	transitions := make(transition.Transitions, len(b.transitions))
+	for i, t := range b.transitions {
+		transitions[i] = transition.Transition{
+			StartState:  rename(t.StartState),
+			Input:       t.Input,
+			ResultState: rename(t.ResultState),
+		}
+	}
+	b.transitions = transitions
+
+	rangeTransitions := make(transition.RangeTransitions, len(b.rangeTransitions))
+	for i, t := range b.rangeTransitions {
+		rangeTransitions[i] = transition.RangeTransition{
+			StartState:  rename(t.StartState),
+			Runes:       t.Runes,
+			ResultState: rename(t.ResultState),
+		}
+	}
+	b.rangeTransitions = rangeTransitions
+
+	defaults := make(map[string]string, len(b.defaults))
+	for start, target := range b.defaults {
+		defaults[rename(start)] = rename(target)
+	}
+	b.defaults = defaults
+
+	return b
+}
+
+// RemoveTransition removes all transitions with the start state and input.
+func (b *AutomatonBuilder) RemoveTransition(startState, input string) *AutomatonBuilder {
+	b.transitions = filterTransitions(b.transitions, func(t transition.Transition) bool {
+		return t.StartState != startState || t.Input != input
+	})
+	return b
+}
+
+// RetargetTransitions makes all transitions, including default and range transitions, leading to from lead to to instead.
+func (b *AutomatonBuilder) RetargetTransitions(from, to string) *AutomatonBuilder {
+	transitions := make(transition.Transitions, len(b.transitions))
+	for i, t := range b.transitions {
+		if t.ResultState == from {
+			t.ResultState = to
+		}
+		transitions[i] = t
+	}
+	b.transitions = transitions
+
+	rangeTransitions := make(transition.RangeTransitions, len(b.rangeTransitions))
+	for i, t := range b.rangeTransitions {
+		if t.ResultState == from {
+			t.ResultState = to
+		}
+		rangeTransitions[i] = t
+	}
+	b.rangeTransitions = rangeTransitions
+
+	for start, target := range b.defaults {
+		if target == from {
+			b.defaults[start] = to
+		}
+	}
+
+	return b
+}
+
+// RemoveFinalState removes a state from the final states, the state itself is kept.
+func (b *AutomatonBuilder) RemoveFinalState(state string) *AutomatonBuilder {
+	b.finalStates = without(b.finalStates, state)
+	return b
+}
+
+// Alphabet declares the valid inputs of the automaton.
+// Transitions may only use declared inputs, and declared inputs without a transition are rejected rather than invalid.
+// Without a declared alphabet, the inputs of the transitions are the valid inputs.
+func (b *AutomatonBuilder) Alphabet(inputs ...string) *AutomatonBuilder {
+	b.alphabet = inputs
+	return b
+}
+
+// DefaultTransition sets the state to transition to from state for any input without a transition.
+func (b *AutomatonBuilder) DefaultTransition(state, target string) *AutomatonBuilder {
+	b.defaults[state] = target
+	return b
+}
+
+// RequireUsefulStates makes validation fail if a state is unreachable from the initial state
+// or can't reach a final state.
+func (b *AutomatonBuilder) RequireUsefulStates() *AutomatonBuilder {
+	b.requireUsefulStates = true
+	return b
+}
+
+// Strict makes validation fail with a ConflictingTransitionError if two transitions
+// have the same start state and input but a different result state, instead of using the newer transition.
+func (b *AutomatonBuilder) Strict() *AutomatonBuilder {
+	b.strict = true
+	return b
+}
+
 // Validate validates the current configuration.
+// It returns the first error found, use Lint to collect all errors and warnings.
 func (b *AutomatonBuilder) Validate() error {
-	return validation.ValidateAll(b.states, b.initialState, b.finalStates, b.transitions)
+	errs := b.Lint().Errors()
+	if len(errs) > 0 {
+		return errs[0].Err
+	}
+
+	return nil
+}
+
+// Lint validates the current configuration and reports every error and warning.
+func (b *AutomatonBuilder) Lint() *ValidationReport {
+	return validation.Lint(validation.Definition{
+		States:              b.states,
+		InitialState:        b.initialState,
+		FinalStates:         b.finalStates,
+		Transitions:         b.transitions,
+		Alphabet:            b.alphabet,
+		Defaults:            b.defaults,
+		RangeTransitions:    b.rangeTransitions,
+		RequireUsefulStates: b.requireUsefulStates,
+		Strict:              b.strict,
+	})
 }
 
 // Reset clears all configuration and returns a fresh builder.
@@ -83,5 +331,62 @@ func (b *AutomatonBuilder) Build() (*automaton.FiniteAutomation, error) {
 		return nil, err
 	}
 
-	return automaton.NewFiniteAutomation(b.states, b.initialState, b.finalStates, b.transitions)
+	fa, err := automaton.NewFiniteAutomation(b.states, b.initialState, b.finalStates, b.transitions)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.alphabet != nil {
+		fa.TransitionInputs = slices.Clone(b.alphabet)
+	}
+
+	for state, target := range b.defaults {
+		err = fa.States.SetDefault(state, target)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, t := range b.rangeTransitions {
+		err = fa.States.SetRange(t.StartState, t.Runes, t.ResultState)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return fa, nil
+}
+
+// without returns a copy of states without state.
+func without(states []string, state string) []string {
+	result := make([]string, 0, len(states))
+	for _, s := range states {
+		if s != state {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+// mapStates returns a copy of states with f applied to every state.
+func mapStates(states []string, f func(string) string) []string {
+	result := make([]string, len(states))
+	for i, s := range states {
+		result[i] = f(s)
+	}
+
+	return result
+}
+
+// filterTransitions returns a copy of transitions with only the transitions for which keep returns true.
+func filterTransitions(transitions transition.Transitions, keep func(transition.Transition) bool) transition.Transitions {
+	result := make(transition.Transitions, 0, len(transitions))
+	for _, t := range transitions {
+		if keep(t) {
+			result = append(result, t)
+		}
+	}
+
+	return result
 }
@@ -1,10 +1,13 @@
 package builder_test
 
 import (
+	"strings"
 	"testing"
+	"unicode"
 
 	"github.com/amitprajapati027/finite-automation/builder"
 	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/internal/validation"
 	"github.com/amitprajapati027/finite-automation/transition"
 	"github.com/stretchr/testify/assert"
 )
@@ -166,3 +169,410 @@ func TestAutomationBuilder_Build(t *testing.T) {
 		assert.Nil(t, a)
 	})
 }
+
+func TestAutomationBuilder_RequireUsefulStates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ab := builder.
+			NewAutomatonBuilder().
+			States("s1", "s2").
+			InitialState("s1").
+			FinalStates("s2").
+			AddTransition(transition.Transition{StartState: "s1", Input: "1", ResultState: "s2"}).
+			RequireUsefulStates()
+
+		assert.NoError(t, ab.Validate())
+	})
+
+	t.Run("unreachable final state", func(t *testing.T) {
+		ab := builder.
+			NewAutomatonBuilder().
+			States("s1", "s2", "s3").
+			InitialState("s1").
+			FinalStates("s2", "s3").
+			AddTransition(transition.Transition{StartState: "s1", Input: "1", ResultState: "s2"})
+
+		assert.NoError(t, ab.Validate())
+
+		_, err := ab.RequireUsefulStates().Build()
+		assert.ErrorIs(t, err, validation.ErrUnreachableState)
+		assert.EqualError(t, err, "error state is not reachable from the initial state - s3")
+	})
+}
+
+func TestAutomationBuilder_DefaultTransition(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a, err := builder.
+			NewAutomatonBuilder().
+			States("s1", "s2", "s3").
+			InitialState("s1").
+			FinalStates("s2").
+			Transitions(
+				transition.Transition{StartState: "s1", Input: "a", ResultState: "s2"},
+				transition.Transition{StartState: "s1", Input: "b", ResultState: "s1"},
+			).
+			DefaultTransition("s2", "s3").
+			Build()
+		assert.NoError(t, err)
+
+		result, err := a.Execute("a")
+		assert.NoError(t, err)
+		assert.Equal(t, "s2", result)
+
+		_, err = a.Execute("a", "b")
+		assert.EqualError(t, err, "state s3 is not a final state")
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		_, err := builder.
+			NewAutomatonBuilder().
+			States("s1", "s2").
+			InitialState("s1").
+			FinalStates("s2").
+			AddTransition(transition.Transition{StartState: "s1", Input: "a", ResultState: "s2"}).
+			DefaultTransition("s2", "s3").
+			Build()
+		assert.ErrorIs(t, err, validation.ErrInvalidTransitionState)
+	})
+}
+
+func TestAutomationBuilder_Alphabet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a, err := builder.
+			NewAutomatonBuilder().
+			States("s1", "s2").
+			InitialState("s1").
+			FinalStates("s2").
+			Alphabet("a", "b", "c").
+			AddTransition(transition.Transition{StartState: "s1", Input: "a", ResultState: "s2"}).
+			Build()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, a.TransitionInputs)
+
+		accepted, err := a.Accepts("c")
+		assert.NoError(t, err)
+		assert.False(t, accepted)
+
+		_, err = a.Accepts("d")
+		assert.ErrorIs(t, err, validation.ErrInvalidInput)
+	})
+
+	t.Run("transition input not in alphabet", func(t *testing.T) {
+		_, err := builder.
+			NewAutomatonBuilder().
+			States("s1", "s2").
+			InitialState("s1").
+			FinalStates("s2").
+			Alphabet("b").
+			AddTransition(transition.Transition{StartState: "s1", Input: "a", ResultState: "s2"}).
+			Build()
+		assert.ErrorIs(t, err, validation.ErrInvalidTransitionInput)
+	})
+}
+
+func TestAutomationBuilder_Lint(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		report := builder.
+			NewAutomatonBuilder().
+			States("s1", "s2").
+			InitialState("s1").
+			FinalStates("s2").
+			AddTransition(transition.Transition{StartState: "s1", Input: "1", ResultState: "s2"}).
+			Lint()
+
+		assert.Empty(t, report.Issues)
+	})
+
+	t.Run("reports every problem", func(t *testing.T) {
+		report := builder.
+			NewAutomatonBuilder().
+			States("s1", "s2", "s3").
+			InitialState("s1").
+			FinalStates("s2", "s4").
+			Transitions(
+				transition.Transition{StartState: "s1", Input: "1", ResultState: "s5"},
+				transition.Transition{StartState: "s1", Input: "1", ResultState: "s2"},
+			).
+			Lint()
+
+		assert.Len(t, report.Errors(), 2)
+		assert.Len(t, report.Warnings(), 2)
+		for _, issue := range report.Warnings() {
+			assert.Equal(t, builder.SeverityWarning, issue.Severity)
+			assert.True(t, strings.HasPrefix(issue.String(), "warning: "), issue.String())
+			assert.NotContains(t, issue.String(), "error")
+		}
+		assert.ErrorIs(t, report.Err(), validation.ErrInvalidFinalState)
+		assert.ErrorIs(t, report.Err(), validation.ErrInvalidTransitionState)
+	})
+}
+
+func TestAutomationBuilder_Strict(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		_, err := builder.
+			NewAutomatonBuilder().
+			States("s1", "s2").
+			InitialState("s1").
+			FinalStates("s2").
+			AddTransition(transition.Transition{StartState: "s1", Input: "1", ResultState: "s2"}).
+			Strict().
+			Build()
+		assert.NoError(t, err)
+	})
+
+	t.Run("conflicting transition", func(t *testing.T) {
+		ab := builder.
+			NewAutomatonBuilder().
+			States("s1", "s2").
+			InitialState("s1").
+			FinalStates("s2").
+			Transitions(
+				transition.Transition{StartState: "s1", Input: "1", ResultState: "s2"},
+				transition.Transition{StartState: "s1", Input: "1", ResultState: "s1"},
+			)
+
+		_, err := ab.Build()
+		assert.NoError(t, err)
+
+		a, err := ab.Strict().Build()
+		assert.Nil(t, a)
+		assert.ErrorIs(t, err, builder.ErrConflictingTransition)
+
+		var conflict *builder.ConflictingTransitionError
+		assert.ErrorAs(t, err, &conflict)
+		assert.Equal(t, 0, conflict.FirstIndex)
+		assert.Equal(t, 1, conflict.SecondIndex)
+	})
+}
+
+// newOrderFlowBuilder returns a builder for a small order flow.
+func newOrderFlowBuilder() *builder.AutomatonBuilder {
+	return builder.
+		NewAutomatonBuilder().
+		States("created", "paid", "cancelled", "shipped").
+		InitialState("created").
+		FinalStates("cancelled", "shipped").
+		Transitions(
+			transition.Transition{StartState: "created", Input: "pay", ResultState: "paid"},
+			transition.Transition{StartState: "created", Input: "cancel", ResultState: "cancelled"},
+			transition.Transition{StartState: "paid", Input: "cancel", ResultState: "cancelled"},
+			transition.Transition{StartState: "paid", Input: "ship", ResultState: "shipped"},
+		)
+}
+
+func TestAutomationBuilder_RemoveState(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a, err := newOrderFlowBuilder().
+			DefaultTransition("shipped", "cancelled").
+			RemoveState("cancelled").
+			Build()
+		assert.NoError(t, err)
+
+		expected, err := builder.
+			NewAutomatonBuilder().
+			States("created", "paid", "shipped").
+			InitialState("created").
+			FinalStates("shipped").
+			Transitions(
+				transition.Transition{StartState: "created", Input: "pay", ResultState: "paid"},
+				transition.Transition{StartState: "paid", Input: "ship", ResultState: "shipped"},
+			).
+			Build()
+		assert.NoError(t, err)
+
+		assert.Equal(t, expected.Fingerprint(), a.Fingerprint())
+	})
+
+	t.Run("initial state", func(t *testing.T) {
+		err := newOrderFlowBuilder().RemoveState("created").Validate()
+		assert.ErrorIs(t, err, validation.ErrInitialStateNotDefined)
+	})
+
+	t.Run("does not modify arguments", func(t *testing.T) {
+		states := []string{"s1", "s2"}
+		builder.NewAutomatonBuilder().States(states...).RemoveState("s1")
+
+		assert.Equal(t, []string{"s1", "s2"}, states)
+	})
+}
+
+func TestAutomationBuilder_RenameState(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a, err := newOrderFlowBuilder().
+			DefaultTransition("paid", "created").
+			RenameState("created", "new").
+			RenameState("paid", "payment_received").
+			Build()
+		assert.NoError(t, err)
+
+		expected, err := builder.
+			NewAutomatonBuilder().
+			States("new", "payment_received", "cancelled", "shipped").
+			InitialState("new").
+			FinalStates("cancelled", "shipped").
+			Transitions(
+				transition.Transition{StartState: "new", Input: "pay", ResultState: "payment_received"},
+				transition.Transition{StartState: "new", Input: "cancel", ResultState: "cancelled"},
+				transition.Transition{StartState: "payment_received", Input: "cancel", ResultState: "cancelled"},
+				transition.Transition{StartState: "payment_received", Input: "ship", ResultState: "shipped"},
+			).
+			DefaultTransition("payment_received", "new").
+			Build()
+		assert.NoError(t, err)
+
+		assert.Equal(t, expected.Fingerprint(), a.Fingerprint())
+	})
+}
+
+func TestAutomationBuilder_RemoveTransition(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a, err := newOrderFlowBuilder().
+			AddTransition(transition.Transition{StartState: "paid", Input: "cancel", ResultState: "created"}).
+			RemoveTransition("paid", "cancel").
+			Build()
+		assert.NoError(t, err)
+
+		accepted, err := a.Accepts("pay", "cancel")
+		assert.NoError(t, err)
+		assert.False(t, accepted)
+
+		accepted, err = a.Accepts("pay", "ship")
+		assert.NoError(t, err)
+		assert.True(t, accepted)
+	})
+}
+
+func TestAutomationBuilder_RetargetTransitions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a, err := newOrderFlowBuilder().
+			AddState("refunded").
+			AddFinalState("refunded").
+			DefaultTransition("shipped", "cancelled").
+			RetargetTransitions("cancelled", "refunded").
+			Build()
+		assert.NoError(t, err)
+
+		result, err := a.Execute("pay", "cancel")
+		assert.NoError(t, err)
+		assert.Equal(t, "refunded", result)
+
+		result, err = a.Execute("pay", "ship", "pay")
+		assert.NoError(t, err)
+		assert.Equal(t, "refunded", result)
+	})
+}
+
+func TestAutomationBuilder_RemoveFinalState(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a, err := newOrderFlowBuilder().
+			RemoveFinalState("cancelled").
+			Build()
+		assert.NoError(t, err)
+		assert.Len(t, a.States, 4)
+
+		accepted, err := a.Accepts("cancel")
+		assert.NoError(t, err)
+		assert.False(t, accepted)
+	})
+}
+
+// newIdentifierBuilder configures an automaton accepting identifiers.
+func newIdentifierBuilder() *builder.AutomatonBuilder {
+	letters := transition.RuneSetOf(unicode.Letter).Union(transition.Runes('_'))
+	return builder.
+		NewAutomatonBuilder().
+		States("start", "ident").
+		InitialState("start").
+		FinalStates("ident").
+		AddRangeTransition(transition.RangeTransition{StartState: "start", Runes: letters, ResultState: "ident"}).
+		AddRangeTransition(transition.RangeTransition{StartState: "ident", Runes: letters.Union(transition.Range('0', '9')), ResultState: "ident"})
+}
+
+func TestAutomationBuilder_AddRangeTransition(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a, err := newIdentifierBuilder().Build()
+		assert.NoError(t, err)
+
+		state, err := a.ExecuteString("wörd_42")
+		assert.NoError(t, err)
+		assert.Equal(t, "ident", state)
+
+		_, err = a.ExecuteString("42")
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		_, err := newIdentifierBuilder().
+			AddRangeTransition(transition.RangeTransition{StartState: "ident", Runes: transition.Runes('-'), ResultState: "dash"}).
+			Build()
+		assert.ErrorIs(t, err, validation.ErrInvalidTransitionState)
+	})
+}
+
+func TestAutomationBuilder_RangeTransitions(t *testing.T) {
+	t.Run("edit", func(t *testing.T) {
+		a, err := newIdentifierBuilder().
+			AddState("dash").
+			AddRangeTransition(transition.RangeTransition{StartState: "ident", Runes: transition.Runes('-'), ResultState: "dash"}).
+			RenameState("ident", "name").
+			RetargetTransitions("dash", "start").
+			RemoveState("dash").
+			Build()
+		assert.NoError(t, err)
+
+		state, err := a.ExecuteString("kebab-case")
+		assert.NoError(t, err)
+		assert.Equal(t, "name", state)
+		assert.Len(t, a.RangeTransitions(), 3)
+	})
+}
+
+func TestFromAutomaton(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		original := newOrderFlowBuilder().DefaultTransition("shipped", "cancelled")
+		a, err := original.Build()
+		assert.NoError(t, err)
+
+		rebuilt, err := builder.FromAutomaton(a).Build()
+		assert.NoError(t, err)
+		assert.Equal(t, a, rebuilt)
+	})
+
+	t.Run("declared alphabet", func(t *testing.T) {
+		a, err := newOrderFlowBuilder().Alphabet("pay", "cancel", "ship", "refund").Build()
+		assert.NoError(t, err)
+
+		rebuilt, err := builder.FromAutomaton(a).Build()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"pay", "cancel", "ship", "refund"}, rebuilt.TransitionInputs)
+		assert.Equal(t, a.Fingerprint(), rebuilt.Fingerprint())
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		a, err := newIdentifierBuilder().Build()
+		assert.NoError(t, err)
+
+		rebuilt, err := builder.FromAutomaton(a).Build()
+		assert.NoError(t, err)
+		assert.Equal(t, a.Fingerprint(), rebuilt.Fingerprint())
+	})
+
+	t.Run("modify and rebuild", func(t *testing.T) {
+		a, err := newOrderFlowBuilder().Build()
+		assert.NoError(t, err)
+
+		modified, err := builder.
+			FromAutomaton(a).
+			AddState("refunded").
+			AddFinalState("refunded").
+			AddTransition(transition.Transition{StartState: "shipped", Input: "refund", ResultState: "refunded"}).
+			Build()
+		assert.NoError(t, err)
+
+		result, err := modified.Execute("pay", "ship", "refund")
+		assert.NoError(t, err)
+		assert.Equal(t, "refunded", result)
+
+		// The original automaton is left untouched.
+		assert.Len(t, a.States, 4)
+	})
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/amitprajapati027/finite-automation/builder"
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+// definition is the JSON representation of an automaton.
+type definition struct {
+	States             []string               `json:"states"`
+	InitialState       string                 `json:"initialState"`
+	Alphabet           []string               `json:"alphabet"`
+	FinalStates        []string               `json:"finalStates"`
+	Transitions        []transitionDefinition `json:"transitions"`
+	DefaultTransitions map[string]string      `json:"defaultTransitions"`
+}
+
+// transitionDefinition is the JSON representation of a transition.
+type transitionDefinition struct {
+	StartState  string `json:"startState"`
+	Input       string `json:"input"`
+	ResultState string `json:"resultState"`
+}
+
+// load reads a definition file and builds the automaton.
+func load(path string) (*automaton.FiniteAutomation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var def definition
+	err = json.Unmarshal(data, &def)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	transitions := make(transition.Transitions, 0, len(def.Transitions))
+	for _, t := range def.Transitions {
+		transitions = append(transitions, transition.Transition(t))
+	}
+
+	b := builder.
+		NewAutomatonBuilder().
+		States(def.States...).
+		InitialState(def.InitialState).
+		FinalStates(def.FinalStates...).
+		Transitions(transitions...)
+	if def.Alphabet != nil {
+		b.Alphabet(def.Alphabet...)
+	}
+	for state, target := range def.DefaultTransitions {
+		b.DefaultTransition(state, target)
+	}
+
+	fa, err := b.Build()
+	if err != nil {
+		return nil, fmt.Errorf("error building %s: %w", path, err)
+	}
+
+	return fa, nil
+}
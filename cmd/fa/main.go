@@ -0,0 +1,103 @@
+// Command fa inspects finite automata defined in JSON files.
+//
+// Usage:
+//
+//	fa diff [-json] <old> <new>
+//
+// A definition file looks like:
+//
+//	{
+//		"states": ["S0", "S1"],
+//		"initialState": "S0",
+//		"finalStates": ["S1"],
+//		"alphabet": ["0", "1"],
+//		"transitions": [{"startState": "S0", "input": "1", "resultState": "S1"}]
+//	}
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+)
+
+const usage = "usage: fa diff [-json] <old> <new>"
+
+// Exit codes, following diff(1).
+const (
+	exitSame  = 0
+	exitDiff  = 1
+	exitError = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command and returns the exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) < 1 {
+		fmt.Fprintln(stderr, usage)
+		return exitError
+	}
+
+	switch args[0] {
+	case "diff":
+		return runDiff(args[1:], stdout, stderr)
+	default:
+		fmt.Fprintf(stderr, "unknown command %s\n%s\n", args[0], usage)
+		return exitError
+	}
+}
+
+// runDiff compares two definition files.
+func runDiff(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	asJSON := flags.Bool("json", false, "print the diff as JSON")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return exitError
+	}
+
+	if flags.NArg() != 2 {
+		fmt.Fprintln(stderr, usage)
+		return exitError
+	}
+
+	a, err := load(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	b, err := load(flags.Arg(1))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	report := automaton.Diff(a, b)
+	if *asJSON {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(report)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitError
+		}
+	} else {
+		fmt.Fprint(stdout, report.String())
+	}
+
+	if report.IsEmpty() {
+		return exitSame
+	}
+
+	return exitDiff
+}
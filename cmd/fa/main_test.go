@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const modulo3 = `{
+	"states": ["S0", "S1", "S2"],
+	"initialState": "S0",
+	"finalStates": ["S0"],
+	"transitions": [
+		{"startState": "S0", "input": "0", "resultState": "S0"},
+		{"startState": "S0", "input": "1", "resultState": "S1"},
+		{"startState": "S1", "input": "0", "resultState": "S2"},
+		{"startState": "S1", "input": "1", "resultState": "S0"},
+		{"startState": "S2", "input": "0", "resultState": "S1"},
+		{"startState": "S2", "input": "1", "resultState": "S2"}
+	]
+}`
+
+const modulo3Broken = `{
+	"states": ["S0", "S1", "S2"],
+	"initialState": "S0",
+	"finalStates": ["S0"],
+	"transitions": [
+		{"startState": "S0", "input": "0", "resultState": "S0"},
+		{"startState": "S0", "input": "1", "resultState": "S1"},
+		{"startState": "S1", "input": "0", "resultState": "S2"},
+		{"startState": "S1", "input": "1", "resultState": "S0"},
+		{"startState": "S2", "input": "0", "resultState": "S2"},
+		{"startState": "S2", "input": "1", "resultState": "S2"}
+	]
+}`
+
+// writeFile writes content to a file in a temporary directory and returns its path.
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestRun(t *testing.T) {
+	t.Run("no command", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		assert.Equal(t, exitError, run(nil, &stdout, &stderr))
+		assert.Contains(t, stderr.String(), usage)
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		assert.Equal(t, exitError, run([]string{"merge"}, &stdout, &stderr))
+		assert.Contains(t, stderr.String(), "unknown command merge")
+	})
+}
+
+func TestRunDiff(t *testing.T) {
+	t.Run("no differences", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		a := writeFile(t, "a.json", modulo3)
+		b := writeFile(t, "b.json", modulo3)
+
+		assert.Equal(t, exitSame, run([]string{"diff", a, b}, &stdout, &stderr))
+		assert.Equal(t, "language unchanged\n", stdout.String())
+	})
+
+	t.Run("differences", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		a := writeFile(t, "a.json", modulo3)
+		b := writeFile(t, "b.json", modulo3Broken)
+
+		assert.Equal(t, exitDiff, run([]string{"diff", a, b}, &stdout, &stderr))
+		assert.Equal(t, `~ transition S2 --0--> S1 -> S2
+language changed: input ["1" "0" "0" "1"] is now rejected
+`, stdout.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		a := writeFile(t, "a.json", modulo3)
+		b := writeFile(t, "b.json", modulo3Broken)
+
+		assert.Equal(t, exitDiff, run([]string{"diff", "-json", a, b}, &stdout, &stderr))
+		assert.JSONEq(t, `{
+			"addedStates": [],
+			"removedStates": [],
+			"finalChanges": [],
+			"addedTransitions": [],
+			"removedTransitions": [],
+			"retargetedTransitions": [{"startState": "S2", "input": "0", "from": "S1", "to": "S2"}],
+			"addedDefaults": [],
+			"removedDefaults": [],
+			"retargetedDefaults": [],
+			"addedRanges": [],
+			"removedRanges": [],
+			"languageChanged": true,
+			"witness": ["1", "0", "0", "1"],
+			"witnessAccepted": false
+		}`, stdout.String())
+	})
+
+	t.Run("default transitions", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		a := writeFile(t, "a.json", strings.Replace(modulo3, `"finalStates"`, `"defaultTransitions": {"S0": "S1"}, "finalStates"`, 1))
+		b := writeFile(t, "b.json", strings.Replace(modulo3, `"finalStates"`, `"defaultTransitions": {"S0": "S2"}, "finalStates"`, 1))
+
+		assert.Equal(t, exitDiff, run([]string{"diff", a, b}, &stdout, &stderr))
+		assert.Equal(t, "~ default S0 --> S1 -> S2\nlanguage unchanged\n", stdout.String())
+	})
+
+	t.Run("missing arguments", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		assert.Equal(t, exitError, run([]string{"diff", "a.json"}, &stdout, &stderr))
+		assert.Contains(t, stderr.String(), usage)
+	})
+
+	t.Run("invalid definition", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		a := writeFile(t, "a.json", modulo3)
+		b := writeFile(t, "b.json", `{"states": []}`)
+
+		assert.Equal(t, exitError, run([]string{"diff", a, b}, &stdout, &stderr))
+		assert.Contains(t, stderr.String(), "error automaton states is empty")
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		a := writeFile(t, "a.json", modulo3)
+
+		assert.Equal(t, exitError, run([]string{"diff", a, filepath.Join(t.TempDir(), "missing.json")}, &stdout, &stderr))
+		assert.NotEmpty(t, stderr.String())
+	})
+}
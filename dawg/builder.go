@@ -0,0 +1,304 @@
+package dawg
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/maphash"
+	"io"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+)
+
+var (
+	ErrUnsorted    = errors.New("error words are not sorted")
+	ErrFinished    = errors.New("error builder is finished")
+	ErrInvalidWord = errors.New("error word is not valid UTF-8")
+)
+
+// root is the index of the initial state.
+const root = 0
+
+// edge is a transition to the state with the target index.
+type edge struct {
+	label  rune
+	target int
+}
+
+// node is a state of the automation under construction.
+type node struct {
+	// final is true if a word ends in the state.
+	final bool
+
+	// edges are ordered by label.
+	edges []edge
+}
+
+// Builder incrementally constructs the minimal acyclic automation of a sorted stream of words,
+// using the algorithm of Daciuk, Mihov, Watson and Watson. Only the states of the last word can still
+// change, the others are minimized as soon as a word diverges from them, so the builder never holds
+// more than the minimal automation and one path.
+type Builder struct {
+	// nodes contains the states, the root first. Discarded states are reused.
+	nodes []node
+
+	// free contains the indexes of discarded states.
+	free []int
+
+	// register maps the signature of minimized states to their indexes.
+	register map[uint64][]int
+
+	// seed seeds the signatures.
+	seed maphash.Seed
+
+	// path contains the states of the last word, the root first.
+	path []int
+
+	// last is the last word added.
+	last string
+
+	// finished is true once Finish was called.
+	finished bool
+
+	// budget bounds the states and transitions kept.
+	budget *automaton.Budget
+}
+
+// NewBuilder returns a builder for an empty dictionary. The limits bound the states and transitions
+// kept by the builder, discarded ones don't count, and it stops when the context is done.
+func NewBuilder(ctx context.Context, limits automaton.Limits) *Builder {
+	b := &Builder{
+		nodes:    []node{{}},
+		free:     make([]int, 0),
+		register: make(map[uint64][]int),
+		seed:     maphash.MakeSeed(),
+		path:     []int{root},
+		budget:   automaton.NewBudget(ctx, limits),
+	}
+
+	// The root is never discarded, so it's always counted.
+	_ = b.budget.AddState()
+
+	return b
+}
+
+// Add adds a word, which must be valid UTF-8 and not less than the last word added in byte order.
+// Adding the last word again does nothing. After an error other than ErrUnsorted and ErrInvalidWord
+// the builder must not be used.
+func (b *Builder) Add(word string) error {
+	if b.finished {
+		return ErrFinished
+	}
+	if !utf8.ValidString(word) {
+		return fmt.Errorf("%w - %q", ErrInvalidWord, word)
+	}
+	if word < b.last {
+		return fmt.Errorf("%w - %q after %q", ErrUnsorted, word, b.last)
+	}
+	if word == b.last && b.nodes[b.path[len(b.path)-1]].final {
+		return nil
+	}
+
+	// The common prefix ends at a rune boundary of both words.
+	common, runes := 0, 0
+	for common < len(word) && common < len(b.last) {
+		r, size := utf8.DecodeRuneInString(word[common:])
+		if last, lastSize := utf8.DecodeRuneInString(b.last[common:]); r != last || size != lastSize {
+			break
+		}
+		common += size
+		runes++
+	}
+
+	b.minimize(runes)
+
+	q := b.path[len(b.path)-1]
+	for _, r := range word[common:] {
+		next, err := b.newNode()
+		if err != nil {
+			return fmt.Errorf("error adding word %q: %w", word, err)
+		}
+		if err := b.budget.AddTransition(); err != nil {
+			return fmt.Errorf("error adding word %q: %w", word, err)
+		}
+
+		b.nodes[q].edges = append(b.nodes[q].edges, edge{label: r, target: next})
+		b.path = append(b.path, next)
+		q = next
+	}
+	b.nodes[q].final = true
+	b.last = word
+
+	return nil
+}
+
+// Finish minimizes the last word and returns the dictionary. The builder can't be used afterwards.
+func (b *Builder) Finish() (*DAWG, error) {
+	if b.finished {
+		return nil, ErrFinished
+	}
+
+	b.minimize(0)
+	b.finished = true
+
+	return b.compact(), nil
+}
+
+// Build returns the dictionary of the sorted words.
+func Build(ctx context.Context, words []string, limits automaton.Limits) (*DAWG, error) {
+	b := NewBuilder(ctx, limits)
+	for _, word := range words {
+		if err := b.Add(word); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Finish()
+}
+
+// Read returns the dictionary of the sorted words of r, one per line.
+func Read(ctx context.Context, r io.Reader, limits automaton.Limits) (*DAWG, error) {
+	b := NewBuilder(ctx, limits)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if err := b.Add(scanner.Text()); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading words: %w", err)
+	}
+
+	return b.Finish()
+}
+
+// minimize replaces the states of the path after the first depth ones by equivalent minimized states,
+// or registers them as minimized, deepest first.
+func (b *Builder) minimize(depth int) {
+	for i := len(b.path) - 1; i > depth; i-- {
+		child, parent := b.path[i], b.path[i-1]
+		signature := b.signature(child)
+
+		replaced := false
+		for _, q := range b.register[signature] {
+			if b.equal(q, child) {
+				b.nodes[parent].edges[len(b.nodes[parent].edges)-1].target = q
+				b.discard(child)
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			b.register[signature] = append(b.register[signature], child)
+		}
+	}
+
+	b.path = b.path[:depth+1]
+}
+
+// newNode returns the index of a new state, reusing a discarded one if possible.
+func (b *Builder) newNode() (int, error) {
+	if err := b.budget.AddState(); err != nil {
+		return 0, err
+	}
+
+	if n := len(b.free); n > 0 {
+		q := b.free[n-1]
+		b.free = b.free[:n-1]
+		return q, nil
+	}
+
+	b.nodes = append(b.nodes, node{})
+	return len(b.nodes) - 1, nil
+}
+
+// discard frees a state and its transitions.
+func (b *Builder) discard(q int) {
+	b.budget.Release(1, len(b.nodes[q].edges))
+	b.nodes[q] = node{}
+	b.free = append(b.free, q)
+}
+
+// signature returns a hash of the finality and transitions of a state.
+// Equivalent minimized states have the same signature.
+func (b *Builder) signature(q int) uint64 {
+	var h maphash.Hash
+	h.SetSeed(b.seed)
+
+	var buf [binary.MaxVarintLen64]byte
+	if b.nodes[q].final {
+		_ = h.WriteByte(1)
+	} else {
+		_ = h.WriteByte(0)
+	}
+	for _, e := range b.nodes[q].edges {
+		_, _ = h.Write(binary.AppendVarint(buf[:0], int64(e.label)))
+		_, _ = h.Write(binary.AppendVarint(buf[:0], int64(e.target)))
+	}
+
+	return h.Sum64()
+}
+
+// equal returns true if the states have the same finality and transitions.
+func (b *Builder) equal(p, q int) bool {
+	a, c := b.nodes[p], b.nodes[q]
+	if a.final != c.final || len(a.edges) != len(c.edges) {
+		return false
+	}
+
+	for i := range a.edges {
+		if a.edges[i] != c.edges[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compact returns the dictionary of the reachable states, numbered in topological order:
+// the reverse of the depth-first post-order, so every transition leads to a greater index.
+func (b *Builder) compact() *DAWG {
+	d := &DAWG{
+		final:   make([]bool, 0),
+		offsets: make([]int, 0),
+		labels:  make([]rune, 0),
+		targets: make([]int, 0),
+	}
+
+	visited := make(map[int]bool)
+	order := make([]int, 0)
+	var visit func(q int)
+	visit = func(q int) {
+		visited[q] = true
+		for _, e := range b.nodes[q].edges {
+			if !visited[e.target] {
+				visit(e.target)
+			}
+		}
+		order = append(order, q)
+	}
+	visit(root)
+	slices.Reverse(order)
+
+	index := make(map[int]int, len(order))
+	for i, q := range order {
+		index[q] = i
+	}
+
+	for _, q := range order {
+		d.final = append(d.final, b.nodes[q].final)
+		d.offsets = append(d.offsets, len(d.labels))
+		for _, e := range b.nodes[q].edges {
+			d.labels = append(d.labels, e.label)
+			d.targets = append(d.targets, index[e.target])
+		}
+	}
+	d.offsets = append(d.offsets, len(d.labels))
+	d.count = d.countWords()
+
+	return d
+}
@@ -0,0 +1,163 @@
+package dawg_test
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/amitprajapati027/finite-automation/dawg"
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// minimalStates returns the number of states of the minimal automaton of the words,
+// which is the number of distinct sets of suffixes completing a prefix of a word.
+func minimalStates(words []string) int {
+	suffixes := make(map[string][]string)
+	for _, word := range words {
+		runes := []rune(word)
+		for i := 0; i <= len(runes); i++ {
+			prefix := string(runes[:i])
+			suffixes[prefix] = append(suffixes[prefix], string(runes[i:]))
+		}
+	}
+
+	languages := make(map[string]bool)
+	for _, s := range suffixes {
+		slices.Sort(s)
+		languages[strings.Join(slices.Compact(s), "\x00")] = true
+	}
+
+	return len(languages)
+}
+
+func TestBuilder_Add(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		b := dawg.NewBuilder(context.Background(), automaton.Limits{})
+		for _, word := range []string{"tap", "taps", "taps", "top", "tops"} {
+			require.NoError(t, b.Add(word))
+		}
+
+		d, err := b.Finish()
+		require.NoError(t, err)
+		assert.Equal(t, 4, d.Len())
+		assert.Equal(t, 5, d.NumStates())
+		assert.Equal(t, 5, d.NumTransitions())
+	})
+
+	t.Run("unsorted", func(t *testing.T) {
+		b := dawg.NewBuilder(context.Background(), automaton.Limits{})
+		require.NoError(t, b.Add("top"))
+
+		assert.ErrorIs(t, b.Add("tap"), dawg.ErrUnsorted)
+	})
+
+	t.Run("invalid word", func(t *testing.T) {
+		b := dawg.NewBuilder(context.Background(), automaton.Limits{})
+		require.NoError(t, b.Add("a\uFFFD"))
+
+		assert.ErrorIs(t, b.Add("a\xff"), dawg.ErrInvalidWord)
+
+		// The builder is unchanged, so it can still be used.
+		require.NoError(t, b.Add("b"))
+		d, err := b.Finish()
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, d.Len())
+		assert.Equal(t, []string{"a\uFFFD", "b"}, slices.Collect(d.Words()))
+		assert.True(t, d.Contains("a\uFFFD"))
+		assert.False(t, d.Contains("a\xfe"))
+		assert.False(t, d.HasPrefix("a\xfe"))
+	})
+
+	t.Run("finished", func(t *testing.T) {
+		b := dawg.NewBuilder(context.Background(), automaton.Limits{})
+		_, err := b.Finish()
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, b.Add("tap"), dawg.ErrFinished)
+		_, err = b.Finish()
+		assert.ErrorIs(t, err, dawg.ErrFinished)
+	})
+
+	t.Run("budget exceeded", func(t *testing.T) {
+		b := dawg.NewBuilder(context.Background(), automaton.Limits{MaxStates: 4})
+		require.NoError(t, b.Add("tap"))
+
+		err := b.Add("top")
+		var exceeded *automaton.BudgetExceededError
+		assert.ErrorAs(t, err, &exceeded)
+		assert.Equal(t, automaton.LimitStates, exceeded.Limit)
+	})
+
+	t.Run("bounded by the minimal automaton", func(t *testing.T) {
+		// Every word shares the suffix of the previous ones, so the builder only holds the minimal
+		// automaton and the path of the last word. Discarded states don't count.
+		words := []string{"aing", "bing", "cing", "ding", "eing", "fing"}
+		_, err := dawg.Build(context.Background(), words, automaton.Limits{MaxStates: minimalStates(words) + len("bing")})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		b := dawg.NewBuilder(ctx, automaton.Limits{})
+		assert.ErrorIs(t, b.Add("tap"), context.Canceled)
+	})
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("minimal", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		for range 20 {
+			words := make([]string, 0)
+			for range rng.IntN(200) {
+				word := make([]rune, rng.IntN(6))
+				for i := range word {
+					word[i] = []rune("abcé")[rng.IntN(4)]
+				}
+				words = append(words, string(word))
+			}
+			slices.Sort(words)
+			words = slices.Compact(words)
+
+			d, err := dawg.Build(context.Background(), words, automaton.Limits{})
+			require.NoError(t, err)
+
+			assert.Equal(t, len(words), d.Len())
+			assert.Equal(t, words, slices.Collect(d.Words()))
+			if len(words) > 0 {
+				assert.Equal(t, minimalStates(words), d.NumStates())
+			}
+		}
+	})
+}
+
+func TestRead(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		d, err := dawg.Read(context.Background(), strings.NewReader("apple\napply\nbanana\n"), automaton.Limits{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"apple", "apply", "banana"}, slices.Collect(d.Words()))
+	})
+
+	t.Run("unsorted", func(t *testing.T) {
+		_, err := dawg.Read(context.Background(), strings.NewReader("banana\napple\n"), automaton.Limits{})
+
+		assert.ErrorIs(t, err, dawg.ErrUnsorted)
+	})
+
+	t.Run("reader error", func(t *testing.T) {
+		failure := errors.New("failure")
+		_, err := dawg.Read(context.Background(), iotest.ErrReader(failure), automaton.Limits{})
+
+		assert.ErrorIs(t, err, failure)
+	})
+}
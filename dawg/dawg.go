@@ -0,0 +1,165 @@
+package dawg
+
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+// DAWG is a dictionary stored as a minimal acyclic automation, sharing both the prefixes and the
+// suffixes of its words. Transitions are stored in flat arrays, ordered by state then rune.
+type DAWG struct {
+	// final describes which states are final states.
+	final []bool
+
+	// offsets contains the index of the first transition of every state, and the number of transitions last.
+	offsets []int
+
+	// labels contains the rune of every transition.
+	labels []rune
+
+	// targets contains the state every transition leads to.
+	targets []int
+
+	// count is the number of words.
+	count int
+}
+
+// Len returns the number of words.
+func (d *DAWG) Len() int {
+	return d.count
+}
+
+// NumStates returns the number of states.
+func (d *DAWG) NumStates() int {
+	return len(d.final)
+}
+
+// NumTransitions returns the number of transitions.
+func (d *DAWG) NumTransitions() int {
+	return len(d.labels)
+}
+
+// Contains returns true if the word is in the dictionary.
+func (d *DAWG) Contains(word string) bool {
+	q, ok := d.walk(word)
+	return ok && d.final[q]
+}
+
+// HasPrefix returns true if a word of the dictionary starts with the prefix.
+func (d *DAWG) HasPrefix(prefix string) bool {
+	_, ok := d.walk(prefix)
+	return ok
+}
+
+// Words returns the words in ascending order.
+func (d *DAWG) Words() iter.Seq[string] {
+	return d.WithPrefix("")
+}
+
+// WithPrefix returns the words starting with the prefix, in ascending order.
+func (d *DAWG) WithPrefix(prefix string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		q, ok := d.walk(prefix)
+		if !ok {
+			return
+		}
+
+		word := []rune(prefix)
+		var visit func(q int) bool
+		visit = func(q int) bool {
+			if d.final[q] && !yield(string(word)) {
+				return false
+			}
+
+			for i := d.offsets[q]; i < d.offsets[q+1]; i++ {
+				word = append(word, d.labels[i])
+				if !visit(d.targets[i]) {
+					return false
+				}
+				word = word[:len(word)-1]
+			}
+
+			return true
+		}
+		visit(q)
+	}
+}
+
+// walk returns the state reached from the initial state with the runes of s.
+// Words are valid UTF-8, so invalid bytes lead nowhere.
+func (d *DAWG) walk(s string) (int, bool) {
+	q := root
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			return 0, false
+		}
+		i += size
+
+		labels := d.labels[d.offsets[q]:d.offsets[q+1]]
+		j, found := slices.BinarySearch(labels, r)
+		if !found {
+			return 0, false
+		}
+		q = d.targets[d.offsets[q]+j]
+	}
+
+	return q, true
+}
+
+// countWords returns the number of words, counting the words accepted from every state
+// in reverse topological order, so the targets of a state are counted before it.
+func (d *DAWG) countWords() int {
+	counts := make([]int, len(d.final))
+	for q := len(d.final) - 1; q >= 0; q-- {
+		if d.final[q] {
+			counts[q] = 1
+		}
+		for i := d.offsets[q]; i < d.offsets[q+1]; i++ {
+			counts[q] += counts[d.targets[i]]
+		}
+	}
+
+	return counts[root]
+}
+
+// Automaton returns the dictionary as a FiniteAutomation over the runes of the words, with a transition
+// for every rune as a single input. States are named D0, D1, and so on, D0 being the initial state.
+func (d *DAWG) Automaton() *automaton.FiniteAutomation {
+	names := make([]string, len(d.final))
+	finals := make([]string, 0)
+	for q := range d.final {
+		names[q] = "D" + strconv.Itoa(q)
+		if d.final[q] {
+			finals = append(finals, names[q])
+		}
+	}
+
+	transitions := make(transition.Transitions, 0, len(d.labels))
+	for q := range d.final {
+		for i := d.offsets[q]; i < d.offsets[q+1]; i++ {
+			transitions = append(transitions, transition.Transition{
+				StartState:  names[q],
+				Input:       string(d.labels[i]),
+				ResultState: names[d.targets[i]],
+			})
+		}
+	}
+
+	// Names are unique and transitions only use them, so the construction can't fail.
+	fa, _ := automaton.NewFiniteAutomation(names, names[root], finals, transitions)
+
+	alphabet := slices.Clone(d.labels)
+	slices.Sort(alphabet)
+	fa.TransitionInputs = make([]string, 0)
+	for _, r := range slices.Compact(alphabet) {
+		fa.TransitionInputs = append(fa.TransitionInputs, string(r))
+	}
+
+	return fa
+}
@@ -0,0 +1,87 @@
+package dawg_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/dawg"
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLexicon builds a dictionary of a few words.
+func newLexicon(t *testing.T) *dawg.DAWG {
+	t.Helper()
+
+	d, err := dawg.Build(context.Background(), []string{"car", "card", "cards", "care", "cart", "dog", "dogs"}, automaton.Limits{})
+	require.NoError(t, err)
+
+	return d
+}
+
+func TestDAWG_Contains(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		d := newLexicon(t)
+
+		assert.True(t, d.Contains("card"))
+		assert.True(t, d.Contains("dogs"))
+		assert.False(t, d.Contains("ca"))
+		assert.False(t, d.Contains("cars"))
+		assert.False(t, d.Contains(""))
+	})
+}
+
+func TestDAWG_HasPrefix(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		d := newLexicon(t)
+
+		assert.True(t, d.HasPrefix("ca"))
+		assert.True(t, d.HasPrefix(""))
+		assert.False(t, d.HasPrefix("cat"))
+	})
+}
+
+func TestDAWG_WithPrefix(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		d := newLexicon(t)
+
+		assert.Equal(t, []string{"card", "cards"}, slices.Collect(d.WithPrefix("card")))
+		assert.Equal(t, []string{"car", "card", "cards", "care", "cart"}, slices.Collect(d.WithPrefix("c")))
+		assert.Empty(t, slices.Collect(d.WithPrefix("x")))
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		d := newLexicon(t)
+
+		words := make([]string, 0)
+		for word := range d.Words() {
+			words = append(words, word)
+			if len(words) == 2 {
+				break
+			}
+		}
+		assert.Equal(t, []string{"car", "card"}, words)
+	})
+}
+
+func TestDAWG_Automaton(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		d := newLexicon(t)
+
+		fa := d.Automaton()
+		assert.Len(t, fa.States, d.NumStates())
+		assert.Len(t, fa.Transitions(), d.NumTransitions())
+		assert.Equal(t, "D0", fa.InitialState.GetName())
+		assert.Equal(t, []string{"a", "c", "d", "e", "g", "o", "r", "s", "t"}, fa.TransitionInputs)
+
+		for _, word := range []string{"cards", "dog"} {
+			accepted, err := fa.Accepts(strings.Split(word, "")...)
+			require.NoError(t, err)
+			assert.True(t, accepted, word)
+		}
+		assert.Equal(t, int64(2), fa.Count(3).Int64())
+	})
+}
@@ -1,8 +1,160 @@
 package finiteautomation
 
 import (
+	"context"
+
 	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
 )
 
 // FiniteAutomation describes a finite automation.
 type FiniteAutomation = automaton.FiniteAutomation
+
+// State is a node in a finite automation.
+type State = automaton.State
+
+// States is a collection of states.
+type States = automaton.States
+
+// MissingTransition is a state and input without a transition.
+type MissingTransition = automaton.MissingTransition
+
+// WeightFunc returns the relative weight of a transition for random walks.
+type WeightFunc = automaton.WeightFunc
+
+// Machine is a running instance of a finite automation.
+type Machine = automaton.Machine
+
+// Snapshot records the progress of a machine.
+type Snapshot = automaton.Snapshot
+
+// Migration moves machine snapshots from one version of an automaton to another.
+type Migration = automaton.Migration
+
+// StateChanges describes how the states of two versions of an automaton differ.
+type StateChanges = automaton.StateChanges
+
+// NewMigration creates a new migration between two versions of an automaton.
+func NewMigration(from, to *FiniteAutomation) *Migration {
+	return automaton.NewMigration(from, to)
+}
+
+// DiffStates compares the states of two versions of an automaton.
+func DiffStates(from, to *FiniteAutomation) StateChanges {
+	return automaton.DiffStates(from, to)
+}
+
+// DiffReport describes the structural and behavioural differences between two automata.
+type DiffReport = automaton.DiffReport
+
+// Diff compares an old automaton a with a new automaton b.
+func Diff(a, b *FiniteAutomation) *DiffReport {
+	return automaton.Diff(a, b)
+}
+
+// MatchKind selects which match a search reports among those starting at the leftmost position.
+type MatchKind = automaton.MatchKind
+
+const (
+	// LeftmostLongest reports the longest match starting at the leftmost position.
+	LeftmostLongest = automaton.LeftmostLongest
+
+	// LeftmostShortest reports the shortest match starting at the leftmost position.
+	LeftmostShortest = automaton.LeftmostShortest
+)
+
+// SearchOptions configures a search.
+type SearchOptions = automaton.SearchOptions
+
+// Match is a span of inputs accepted by an automation.
+type Match = automaton.Match
+
+// Searcher finds the spans of an input stream accepted by an automation.
+type Searcher = automaton.Searcher
+
+// NFA describes a nondeterministic finite automation.
+type NFA = automaton.NFA
+
+// Epsilon is the input of NFA transitions taken without consuming an input.
+const Epsilon = automaton.Epsilon
+
+// NewNFA creates a new NFA object.
+func NewNFA(Q []string, initial []string, F []string, Delta transition.Transitions) (*NFA, error) {
+	return automaton.NewNFA(Q, initial, F, Delta)
+}
+
+// Limits bounds the resources used by a construction. Zero values mean no limit.
+type Limits = automaton.Limits
+
+// BudgetExceededError describes the limit a construction hit. It wraps ErrBudgetExceeded.
+type BudgetExceededError = automaton.BudgetExceededError
+
+// ErrBudgetExceeded is returned when a construction hits one of its Limits.
+var ErrBudgetExceeded = automaton.ErrBudgetExceeded
+
+// Product returns an automation running a and b in lockstep, accepting an input when accept
+// returns true given whether a and b accept it.
+func Product(a, b *FiniteAutomation, accept func(acceptedByA, acceptedByB bool) bool) *FiniteAutomation {
+	return automaton.Product(a, b, accept)
+}
+
+// ProductContext is like Product, but stops when a limit is hit or the context is done.
+func ProductContext(ctx context.Context, a, b *FiniteAutomation, accept func(acceptedByA, acceptedByB bool) bool, limits Limits) (*FiniteAutomation, error) {
+	return automaton.ProductContext(ctx, a, b, accept, limits)
+}
+
+// Intersection returns an automation accepting the inputs accepted by both a and b.
+func Intersection(a, b *FiniteAutomation) *FiniteAutomation {
+	return automaton.Intersection(a, b)
+}
+
+// Union returns an automation accepting the inputs accepted by a or b.
+func Union(a, b *FiniteAutomation) *FiniteAutomation {
+	return automaton.Union(a, b)
+}
+
+// LazyDFA executes an NFA, creating the states of the subset construction on demand.
+type LazyDFA = automaton.LazyDFA
+
+// LazyOptions configures the state cache of a LazyDFA.
+type LazyOptions = automaton.LazyOptions
+
+// LazyStats describes the work done by a LazyDFA.
+type LazyStats = automaton.LazyStats
+
+// Subset decides whether every input accepted by a is accepted by b,
+// returning an input accepted by a but rejected by b if not.
+func Subset(a, b *FiniteAutomation) (bool, []string) {
+	return automaton.Subset(a, b)
+}
+
+// SubsetNFA decides whether every input accepted by a is accepted by b, without determinizing b,
+// returning an input accepted by a but rejected by b if not.
+func SubsetNFA(a, b *NFA) (bool, []string) {
+	return automaton.SubsetNFA(a, b)
+}
+
+// Concat returns an NFA accepting an input accepted by a followed by an input accepted by b.
+func Concat(a, b *NFA) *NFA {
+	return automaton.Concat(a, b)
+}
+
+// Star returns an NFA accepting zero or more inputs accepted by a.
+func Star(a *NFA) *NFA {
+	return automaton.Star(a)
+}
+
+// Plus returns an NFA accepting one or more inputs accepted by a.
+func Plus(a *NFA) *NFA {
+	return automaton.Plus(a)
+}
+
+// Optional returns an NFA accepting the empty input and the inputs accepted by a.
+func Optional(a *NFA) *NFA {
+	return automaton.Optional(a)
+}
+
+// Reverse returns an NFA accepting the reversed inputs accepted by a.
+func Reverse(a *NFA) *NFA {
+	return automaton.Reverse(a)
+}
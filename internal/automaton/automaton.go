@@ -1,7 +1,11 @@
 package automaton
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/amitprajapati027/finite-automation/internal/validation"
 	"github.com/amitprajapati027/finite-automation/transition"
@@ -12,25 +16,27 @@ type FiniteAutomation struct {
 	// States contains a set of all states.
 	States States
 
-	// TransitionInputs contains all valid inputs to FSA.
+	// TransitionInputs contains all valid inputs to FSA, also known as the alphabet.
+	// Valid inputs without a transition are rejected, other inputs are invalid.
 	TransitionInputs []string
 
 	// InitialState is the initial state.
 	InitialState *State
 }
 
-// Execute runs the automation.
+// Execute runs the automation. Inputs made of a single rune also take range transitions,
+// and the runes of range transitions are valid inputs, as in Accepts.
 func (fa *FiniteAutomation) Execute(Sigma ...string) (string, error) {
-	err := validation.ValidateInputs(Sigma, fa.TransitionInputs)
+	err := fa.validateInputs(Sigma)
 	if err != nil {
 		return "", fmt.Errorf("failed to execute finite automation: %w", err)
 	}
 
 	state := fa.InitialState
 	for _, s := range Sigma {
-		state, err = state.Transition(s)
-		if err != nil {
-			return "", fmt.Errorf("error executing automation: %w", err)
+		state = fa.next(state, s)
+		if state == nil {
+			return "", fmt.Errorf("error executing automation: %w", ErrStateTransitionNotFound)
 		}
 	}
 
@@ -42,6 +48,97 @@ func (fa *FiniteAutomation) Execute(Sigma ...string) (string, error) {
 	return state.GetName(), nil
 }
 
+// Accepts returns true if the automation accepts the inputs.
+// Inputs without a transition are rejected, only inputs outside of the alphabet return an error.
+// The runes of range transitions are valid inputs too.
+func (fa *FiniteAutomation) Accepts(Sigma ...string) (bool, error) {
+	err := fa.validateInputs(Sigma)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute finite automation: %w", err)
+	}
+
+	return accepts(fa.run(Sigma)), nil
+}
+
+// Complement returns an automation accepting exactly the inputs over the alphabet that are rejected.
+// Missing transitions are completed with a new sink state, which becomes final.
+func (fa *FiniteAutomation) Complement() *FiniteAutomation {
+	complemented := fa.complete(fa.TransitionInputs, fa.freshName("sink"))
+	for _, state := range complemented.States {
+		state.final = !state.final
+	}
+
+	return complemented
+}
+
+// freshName returns a state name based on name that is not used by the automation.
+func (fa *FiniteAutomation) freshName(name string) string {
+	candidate := name
+	for i := 1; ; i++ {
+		if _, err := fa.States.Find(candidate); err != nil {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+}
+
+// Fingerprint returns a digest identifying the definition of the automation.
+// Two automations share a fingerprint when they have the same states, final
+// states, initial state, inputs and transitions, including default and range transitions,
+// regardless of declaration order.
+func (fa *FiniteAutomation) Fingerprint() string {
+	lines := make([]string, 0, len(fa.States)+len(fa.TransitionInputs)+1)
+	lines = append(lines, "initial "+fa.InitialState.GetName())
+	for _, state := range fa.States {
+		lines = append(lines, fmt.Sprintf("state %q %t", state.name, state.final))
+	}
+	for _, input := range fa.TransitionInputs {
+		lines = append(lines, fmt.Sprintf("input %q", input))
+	}
+	for _, t := range fa.Transitions() {
+		lines = append(lines, fmt.Sprintf("delta %q %q %q", t.StartState, t.Input, t.ResultState))
+	}
+	for _, state := range fa.States {
+		if state.fallback != nil {
+			lines = append(lines, fmt.Sprintf("default %q %q", state.name, state.fallback.name))
+		}
+	}
+	for _, t := range fa.RangeTransitions() {
+		lines = append(lines, fmt.Sprintf("range %q %q %q", t.StartState, t.Runes, t.ResultState))
+	}
+
+	// Sort the lines, so declaration order doesn't change the fingerprint.
+	slices.Sort(lines)
+
+	sum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// validateInputs validates that the inputs are in the alphabet or runes of range transitions.
+func (fa *FiniteAutomation) validateInputs(Sigma []string) error {
+	return validation.ValidateInputs(slices.DeleteFunc(slices.Clone(Sigma), fa.inRanges), fa.TransitionInputs)
+}
+
+// next returns the state reached from s on input, or nil if there is none.
+// A nil state has no transitions, and default transitions only apply to valid inputs.
+func (fa *FiniteAutomation) next(s *State, input string) *State {
+	if next := s.move(input, false); next != nil || s == nil || s.fallback == nil {
+		return next
+	}
+
+	return s.move(input, fa.isInput(input))
+}
+
+// run returns the state reached from the initial state on the inputs, or nil if there is none.
+func (fa *FiniteAutomation) run(Sigma []string) *State {
+	state := fa.InitialState
+	for _, s := range Sigma {
+		state = fa.next(state, s)
+	}
+
+	return state
+}
+
 // NewFiniteAutomation creates a new FiniteAutomation object.
 func NewFiniteAutomation(Q []string, q0 string, F []string, Delta transition.Transitions) (*FiniteAutomation, error) {
 	// Create states.
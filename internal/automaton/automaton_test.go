@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/internal/validation"
 	"github.com/amitprajapati027/finite-automation/transition"
 	"github.com/stretchr/testify/assert"
 )
@@ -72,6 +73,15 @@ func TestFiniteAutomation_Execute(t *testing.T) {
 		assert.EqualError(t, err, "state s2 is not a final state")
 		assert.Zero(t, result)
 	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		result, err := newLowercase(t, "").Execute("q", "x")
+		assert.NoError(t, err)
+		assert.Equal(t, "two", result)
+
+		_, err = newLowercase(t, "").Execute("Q")
+		assert.ErrorIs(t, err, validation.ErrInvalidInput)
+	})
 }
 
 func TestNewFiniteAutomation(t *testing.T) {
@@ -122,3 +132,145 @@ func TestNewFiniteAutomation(t *testing.T) {
 		assert.Zero(t, fa)
 	})
 }
+
+func TestFiniteAutomation_Fingerprint(t *testing.T) {
+	t.Run("declaration order does not matter", func(t *testing.T) {
+		a, err := automaton.NewFiniteAutomation([]string{"s1", "s2"}, "s1", []string{"s2"}, transition.Transitions{
+			{StartState: "s1", Input: "0", ResultState: "s2"},
+			{StartState: "s2", Input: "1", ResultState: "s1"},
+		})
+		assert.NoError(t, err)
+
+		b, err := automaton.NewFiniteAutomation([]string{"s2", "s1"}, "s1", []string{"s2"}, transition.Transitions{
+			{StartState: "s2", Input: "1", ResultState: "s1"},
+			{StartState: "s1", Input: "0", ResultState: "s2"},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, a.Fingerprint(), b.Fingerprint())
+	})
+
+	t.Run("definition changes", func(t *testing.T) {
+		a, err := automaton.NewFiniteAutomation([]string{"s1", "s2"}, "s1", []string{"s2"}, transition.Transitions{
+			{StartState: "s1", Input: "0", ResultState: "s2"},
+		})
+		assert.NoError(t, err)
+
+		b, err := automaton.NewFiniteAutomation([]string{"s1", "s2"}, "s1", []string{"s2"}, transition.Transitions{
+			{StartState: "s1", Input: "0", ResultState: "s1"},
+		})
+		assert.NoError(t, err)
+
+		c, err := automaton.NewFiniteAutomation([]string{"s1", "s2"}, "s2", []string{"s2"}, transition.Transitions{
+			{StartState: "s1", Input: "0", ResultState: "s2"},
+		})
+		assert.NoError(t, err)
+
+		assert.NotEqual(t, a.Fingerprint(), b.Fingerprint())
+		assert.NotEqual(t, a.Fingerprint(), c.Fingerprint())
+	})
+}
+
+func TestFiniteAutomation_Accepts(t *testing.T) {
+	t.Run("accepted", func(t *testing.T) {
+		accepted, err := newModulo3(t).Accepts("1", "1")
+		assert.NoError(t, err)
+		assert.True(t, accepted)
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		accepted, err := newLowercase(t, "").Accepts("q", "x")
+		assert.NoError(t, err)
+		assert.True(t, accepted)
+
+		_, err = newLowercase(t, "").Accepts("Q")
+		assert.Error(t, err)
+	})
+
+	t.Run("not final", func(t *testing.T) {
+		accepted, err := newModulo3(t).Accepts("1", "0")
+		assert.NoError(t, err)
+		assert.False(t, accepted)
+	})
+
+	t.Run("missing transition", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s1", "s2"}, "s1", []string{"s2"}, transition.Transitions{
+			{StartState: "s1", Input: "0", ResultState: "s2"},
+			{StartState: "s1", Input: "1", ResultState: "s1"},
+		})
+		assert.NoError(t, err)
+
+		accepted, err := fa.Accepts("0", "1")
+		assert.NoError(t, err)
+		assert.False(t, accepted)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		accepted, err := newModulo3(t).Accepts("1", "2")
+		assert.ErrorIs(t, err, validation.ErrInvalidInput)
+		assert.False(t, accepted)
+	})
+}
+
+func TestFiniteAutomation_Complement(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s", "sink", "f"}, "s", []string{"f"}, transition.Transitions{
+			{StartState: "s", Input: "a", ResultState: "f"},
+			{StartState: "s", Input: "b", ResultState: "sink"},
+		})
+		assert.NoError(t, err)
+
+		complement := fa.Complement()
+		assert.Equal(t, "sink1", complement.States[len(complement.States)-1].GetName())
+
+		for _, sigma := range [][]string{{}, {"b"}, {"a", "a"}, {"b", "a"}} {
+			accepted, err := complement.Accepts(sigma...)
+			assert.NoError(t, err)
+			assert.True(t, accepted, sigma)
+		}
+
+		accepted, err := complement.Accepts("a")
+		assert.NoError(t, err)
+		assert.False(t, accepted)
+	})
+	t.Run("range transitions", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s", "f"}, "s", []string{"f"}, transition.Transitions{
+			{StartState: "s", Input: "0", ResultState: "f"},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, fa.States.SetRange("s", transition.Range('a', 'z'), "f"))
+
+		complement := fa.Complement()
+		for _, sigma := range [][]string{{}, {"a", "a"}, {"0", "q"}} {
+			accepted, err := complement.Accepts(sigma...)
+			assert.NoError(t, err)
+			assert.True(t, accepted, sigma)
+		}
+		for _, sigma := range [][]string{{"a"}, {"0"}} {
+			accepted, err := complement.Accepts(sigma...)
+			assert.NoError(t, err)
+			assert.False(t, accepted, sigma)
+		}
+
+		assert.True(t, automaton.Union(fa, complement).IsUniversal(nil))
+		assert.True(t, automaton.Intersection(fa, complement).IsEmpty())
+	})
+}
+
+func TestFiniteAutomation_Transitions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s1", "s2"}, "s1", []string{"s2"}, transition.Transitions{
+			{StartState: "s2", Input: "1", ResultState: "s2"},
+			{StartState: "s1", Input: "1", ResultState: "s1"},
+			{StartState: "s1", Input: "0", ResultState: "s1"},
+			{StartState: "s1", Input: "0", ResultState: "s2"},
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, transition.Transitions{
+			{StartState: "s1", Input: "1", ResultState: "s1"},
+			{StartState: "s1", Input: "0", ResultState: "s2"},
+			{StartState: "s2", Input: "1", ResultState: "s2"},
+		}, fa.Transitions())
+	})
+}
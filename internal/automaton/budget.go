@@ -0,0 +1,155 @@
+package automaton
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrBudgetExceeded = errors.New("error construction budget exceeded")
+)
+
+// Limits bounds the resources used by a construction. Zero values mean no limit.
+type Limits struct {
+	// MaxStates is the maximum number of states created.
+	MaxStates int
+
+	// MaxTransitions is the maximum number of transitions created.
+	MaxTransitions int
+
+	// MaxDuration is the maximum time spent.
+	MaxDuration time.Duration
+}
+
+// Limit identifies one of the Limits.
+type Limit int
+
+const (
+	// LimitStates is Limits.MaxStates.
+	LimitStates Limit = iota
+
+	// LimitTransitions is Limits.MaxTransitions.
+	LimitTransitions
+
+	// LimitDuration is Limits.MaxDuration.
+	LimitDuration
+)
+
+// String returns the name of the limit.
+func (l Limit) String() string {
+	switch l {
+	case LimitStates:
+		return "states"
+	case LimitTransitions:
+		return "transitions"
+	case LimitDuration:
+		return "duration"
+	default:
+		return fmt.Sprintf("Limit(%d)", int(l))
+	}
+}
+
+// BudgetExceededError describes the limit a construction hit. It wraps ErrBudgetExceeded.
+type BudgetExceededError struct {
+	// Limit is the limit that was hit.
+	Limit Limit
+
+	// Limits are the limits of the construction.
+	Limits Limits
+}
+
+// Error describes the limit that was hit.
+func (e *BudgetExceededError) Error() string {
+	switch e.Limit {
+	case LimitStates:
+		return fmt.Sprintf("%s - more than %d states", ErrBudgetExceeded, e.Limits.MaxStates)
+	case LimitTransitions:
+		return fmt.Sprintf("%s - more than %d transitions", ErrBudgetExceeded, e.Limits.MaxTransitions)
+	default:
+		return fmt.Sprintf("%s - took longer than %s", ErrBudgetExceeded, e.Limits.MaxDuration)
+	}
+}
+
+// Unwrap returns ErrBudgetExceeded.
+func (e *BudgetExceededError) Unwrap() error {
+	return ErrBudgetExceeded
+}
+
+// budgetCheckInterval is the number of transitions created between two checks of the context and the time.
+const budgetCheckInterval = 1024
+
+// Budget tracks the resources used by a construction against its limits and its context.
+type Budget struct {
+	// ctx cancels the construction.
+	ctx context.Context
+
+	// limits bounds the resources.
+	limits Limits
+
+	// deadline is the time MaxDuration runs out, zero without MaxDuration.
+	deadline time.Time
+
+	// states is the number of states created.
+	states int
+
+	// transitions is the number of transitions created.
+	transitions int
+}
+
+// NewBudget starts tracking a construction.
+func NewBudget(ctx context.Context, limits Limits) *Budget {
+	b := &Budget{ctx: ctx, limits: limits}
+	if limits.MaxDuration > 0 {
+		b.deadline = time.Now().Add(limits.MaxDuration)
+	}
+
+	return b
+}
+
+// AddState records a new state. It returns a BudgetExceededError if a limit is hit,
+// or the context's error if it's done.
+func (b *Budget) AddState() error {
+	b.states++
+	if b.limits.MaxStates > 0 && b.states > b.limits.MaxStates {
+		return &BudgetExceededError{Limit: LimitStates, Limits: b.limits}
+	}
+
+	return b.check()
+}
+
+// AddTransition records a new transition. It returns a BudgetExceededError if a limit is hit,
+// or the context's error if it's done. The context and the time are only checked periodically.
+func (b *Budget) AddTransition() error {
+	b.transitions++
+	if b.limits.MaxTransitions > 0 && b.transitions > b.limits.MaxTransitions {
+		return &BudgetExceededError{Limit: LimitTransitions, Limits: b.limits}
+	}
+
+	if b.transitions%budgetCheckInterval == 0 {
+		return b.check()
+	}
+
+	return nil
+}
+
+// Release records states and transitions discarded by the construction, so they no longer count
+// against the limits. Constructions that free memory as they go use it to bound what they keep.
+func (b *Budget) Release(states, transitions int) {
+	b.states -= states
+	b.transitions -= transitions
+}
+
+// check returns the context's error if it's done, or a BudgetExceededError if the time ran out.
+func (b *Budget) check() error {
+	if err := b.ctx.Err(); err != nil {
+		return err
+	}
+
+	if !b.deadline.IsZero() && time.Now().After(b.deadline) {
+		return &BudgetExceededError{Limit: LimitDuration, Limits: b.limits}
+	}
+
+	return nil
+}
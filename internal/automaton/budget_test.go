@@ -0,0 +1,83 @@
+package automaton_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudget(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		budget := automaton.NewBudget(context.Background(), automaton.Limits{MaxStates: 2, MaxTransitions: 3})
+
+		assert.NoError(t, budget.AddState())
+		assert.NoError(t, budget.AddState())
+		for range 3 {
+			assert.NoError(t, budget.AddTransition())
+		}
+	})
+
+	t.Run("states", func(t *testing.T) {
+		budget := automaton.NewBudget(context.Background(), automaton.Limits{MaxStates: 1})
+
+		assert.NoError(t, budget.AddState())
+
+		err := budget.AddState()
+		assert.ErrorIs(t, err, automaton.ErrBudgetExceeded)
+		assert.EqualError(t, err, "error construction budget exceeded - more than 1 states")
+
+		var exceeded *automaton.BudgetExceededError
+		assert.ErrorAs(t, err, &exceeded)
+		assert.Equal(t, automaton.LimitStates, exceeded.Limit)
+	})
+
+	t.Run("release", func(t *testing.T) {
+		budget := automaton.NewBudget(context.Background(), automaton.Limits{MaxStates: 1, MaxTransitions: 1})
+
+		assert.NoError(t, budget.AddState())
+		assert.NoError(t, budget.AddTransition())
+		budget.Release(1, 1)
+		assert.NoError(t, budget.AddState())
+		assert.NoError(t, budget.AddTransition())
+	})
+
+	t.Run("transitions", func(t *testing.T) {
+		budget := automaton.NewBudget(context.Background(), automaton.Limits{MaxTransitions: 1})
+
+		assert.NoError(t, budget.AddTransition())
+		assert.EqualError(t, budget.AddTransition(), "error construction budget exceeded - more than 1 transitions")
+	})
+
+	t.Run("duration", func(t *testing.T) {
+		budget := automaton.NewBudget(context.Background(), automaton.Limits{MaxDuration: time.Nanosecond})
+		time.Sleep(time.Millisecond)
+
+		err := budget.AddState()
+		assert.EqualError(t, err, "error construction budget exceeded - took longer than 1ns")
+
+		var exceeded *automaton.BudgetExceededError
+		assert.ErrorAs(t, err, &exceeded)
+		assert.Equal(t, automaton.LimitDuration, exceeded.Limit)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := automaton.NewBudget(ctx, automaton.Limits{}).AddState()
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.NotErrorIs(t, err, automaton.ErrBudgetExceeded)
+	})
+}
+
+func TestLimit_String(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, "states", automaton.LimitStates.String())
+		assert.Equal(t, "transitions", automaton.LimitTransitions.String())
+		assert.Equal(t, "duration", automaton.LimitDuration.String())
+		assert.Equal(t, "Limit(7)", automaton.Limit(7).String())
+	})
+}
@@ -0,0 +1,117 @@
+package automaton
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+var (
+	ErrStateAlreadyExists = errors.New("error state already exists")
+)
+
+// MissingTransition is a state and input without a transition.
+type MissingTransition struct {
+	// State is the name of the state.
+	State string `json:"state"`
+
+	// Input is the input without a transition.
+	Input string `json:"input"`
+}
+
+// IsComplete returns true if every state has a transition for every input of the alphabet,
+// along with the missing transitions. A nil alphabet means the inputs of the automation.
+func (fa *FiniteAutomation) IsComplete(alphabet []string) (bool, []MissingTransition) {
+	if alphabet == nil {
+		alphabet = fa.TransitionInputs
+	}
+
+	missing := make([]MissingTransition, 0)
+	for _, state := range fa.States {
+		if state.fallback != nil {
+			continue
+		}
+
+		for _, input := range state.missing(alphabet) {
+			missing = append(missing, MissingTransition{State: state.name, Input: input})
+		}
+	}
+
+	return len(missing) == 0, missing
+}
+
+// Complete returns a copy of the automation with a transition for every state and input of the alphabet,
+// and for every rune of the range transitions. Missing transitions lead to a new non-final sink state
+// named sinkName, which loops on every input.
+// The sink state is only added if a transition is missing. A nil alphabet means the inputs of the automation.
+func (fa *FiniteAutomation) Complete(alphabet []string, sinkName string) (*FiniteAutomation, error) {
+	if _, err := fa.States.Find(sinkName); err == nil {
+		return nil, fmt.Errorf("%w - %s", ErrStateAlreadyExists, sinkName)
+	}
+
+	if alphabet == nil {
+		alphabet = fa.TransitionInputs
+	}
+
+	return fa.complete(alphabet, sinkName), nil
+}
+
+// complete returns a completed copy of the automation, sinkName must not be used by the automation.
+func (fa *FiniteAutomation) complete(alphabet []string, sinkName string) *FiniteAutomation {
+	completed := fa.restrict(fa.all())
+
+	// The runes of range transitions are inputs too, the ones outside of the alphabet are completed with ranges.
+	classes := make(transition.RuneSet, 0)
+	for _, sym := range symbols(fa) {
+		classes = append(classes, sym.class...)
+	}
+	runes := transition.NewRuneSet(classes...)
+
+	for _, input := range alphabet {
+		if !slices.Contains(completed.TransitionInputs, input) {
+			completed.TransitionInputs = append(completed.TransitionInputs, input)
+		}
+	}
+
+	sink := NewState(sinkName)
+	used := false
+	for _, state := range completed.States {
+		if state.fallback != nil {
+			continue
+		}
+
+		for _, input := range state.missing(alphabet) {
+			state.delta[input] = sink
+			used = true
+		}
+
+		if missing := runes.Subtract(state.runes()); !missing.IsEmpty() {
+			state.SetRange(missing, sink)
+			used = true
+		}
+	}
+
+	if used {
+		for _, input := range alphabet {
+			sink.delta[input] = sink
+		}
+		if !runes.IsEmpty() {
+			sink.SetRange(runes, sink)
+		}
+		completed.States = append(completed.States, sink)
+	}
+
+	return completed
+}
+
+// all returns the set of all states.
+func (fa *FiniteAutomation) all() map[*State]bool {
+	all := make(map[*State]bool, len(fa.States))
+	for _, state := range fa.States {
+		all[state] = true
+	}
+
+	return all
+}
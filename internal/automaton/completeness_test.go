@@ -0,0 +1,138 @@
+package automaton_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPartial builds an automation accepting "ab" without transitions for other inputs.
+func newPartial(t *testing.T) *automaton.FiniteAutomation {
+	t.Helper()
+
+	fa, err := automaton.NewFiniteAutomation([]string{"s", "a", "f"}, "s", []string{"f"}, transition.Transitions{
+		{StartState: "s", Input: "a", ResultState: "a"},
+		{StartState: "a", Input: "b", ResultState: "f"},
+	})
+	require.NoError(t, err)
+
+	return fa
+}
+
+func TestFiniteAutomation_IsComplete(t *testing.T) {
+	t.Run("complete", func(t *testing.T) {
+		complete, missing := newModulo3(t).IsComplete(nil)
+
+		assert.True(t, complete)
+		assert.Empty(t, missing)
+	})
+
+	t.Run("missing transitions", func(t *testing.T) {
+		complete, missing := newPartial(t).IsComplete(nil)
+
+		assert.False(t, complete)
+		assert.Equal(t, []automaton.MissingTransition{
+			{State: "s", Input: "b"},
+			{State: "a", Input: "a"},
+			{State: "f", Input: "a"},
+			{State: "f", Input: "b"},
+		}, missing)
+	})
+
+	t.Run("explicit alphabet", func(t *testing.T) {
+		complete, missing := newModulo3(t).IsComplete([]string{"0", "1", "2"})
+
+		assert.False(t, complete)
+		assert.Equal(t, []automaton.MissingTransition{
+			{State: "S0", Input: "2"},
+			{State: "S1", Input: "2"},
+			{State: "S2", Input: "2"},
+		}, missing)
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s"}, "s", []string{"s"}, nil)
+		require.NoError(t, err)
+		require.NoError(t, fa.States.SetRange("s", transition.Range('a', 'z'), "s"))
+		fa.TransitionInputs = []string{"a", "0"}
+
+		// The range transition covers "a".
+		complete, missing := fa.IsComplete(nil)
+		assert.False(t, complete)
+		assert.Equal(t, []automaton.MissingTransition{{State: "s", Input: "0"}}, missing)
+	})
+
+	t.Run("default transitions", func(t *testing.T) {
+		fa := newPartial(t)
+		for _, state := range []string{"s", "a", "f"} {
+			require.NoError(t, fa.States.SetDefault(state, "s"))
+		}
+
+		complete, missing := fa.IsComplete(nil)
+		assert.True(t, complete)
+		assert.Empty(t, missing)
+	})
+}
+
+func TestFiniteAutomation_Complete(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newPartial(t)
+
+		completed, err := fa.Complete(nil, "sink")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"s", "a", "f", "sink"}, names(completed.States))
+
+		complete, _ := completed.IsComplete(nil)
+		assert.True(t, complete)
+
+		result, err := completed.Execute("a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, "f", result)
+
+		_, err = completed.Execute("b", "a")
+		assert.EqualError(t, err, "state sink is not a final state")
+
+		// The original automation is left untouched.
+		complete, _ = fa.IsComplete(nil)
+		assert.False(t, complete)
+	})
+
+	t.Run("explicit alphabet", func(t *testing.T) {
+		completed, err := newModulo3(t).Complete([]string{"0", "1", "2"}, "sink")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"0", "1", "2"}, completed.TransitionInputs)
+
+		_, err = completed.Execute("1", "2", "1")
+		assert.EqualError(t, err, "state sink is not a final state")
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		completed, err := newLowercase(t, "q").Complete(nil, "sink")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"start", "one", "two", "sink"}, names(completed.States))
+
+		state, err := completed.ExecuteString("ab")
+		assert.NoError(t, err)
+		assert.Equal(t, "two", state)
+
+		for _, input := range []string{"q", "qa", "abc"} {
+			_, err := completed.ExecuteString(input)
+			assert.EqualError(t, err, "state sink is not a final state", input)
+		}
+	})
+
+	t.Run("already complete", func(t *testing.T) {
+		completed, err := newModulo3(t).Complete(nil, "sink")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"S0", "S1", "S2"}, names(completed.States))
+	})
+
+	t.Run("sink state exists", func(t *testing.T) {
+		completed, err := newPartial(t).Complete(nil, "f")
+		assert.ErrorIs(t, err, automaton.ErrStateAlreadyExists)
+		assert.Nil(t, completed)
+	})
+}
@@ -0,0 +1,304 @@
+package automaton
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+// FinalChange describes a state whose final flag changed.
+type FinalChange struct {
+	// State is the name of the state.
+	State string `json:"state"`
+
+	// Final is the new final flag.
+	Final bool `json:"final"`
+}
+
+// Retarget describes a transition whose result state changed.
+type Retarget struct {
+	// StartState is the state from which the transition starts.
+	StartState string `json:"startState"`
+
+	// Input is the input of the transition.
+	Input string `json:"input"`
+
+	// From is the old result state.
+	From string `json:"from"`
+
+	// To is the new result state.
+	To string `json:"to"`
+}
+
+// DiffReport describes the structural and behavioural differences between two automata.
+type DiffReport struct {
+	// InitialState contains the old and new initial state, if it changed.
+	InitialState []string `json:"initialState,omitempty"`
+
+	// AddedStates contains the states only present in the new automaton.
+	AddedStates []string `json:"addedStates"`
+
+	// RemovedStates contains the states only present in the old automaton.
+	RemovedStates []string `json:"removedStates"`
+
+	// FinalChanges contains the states present in both automata whose final flag changed.
+	FinalChanges []FinalChange `json:"finalChanges"`
+
+	// AddedTransitions contains the transitions only present in the new automaton.
+	AddedTransitions transition.Transitions `json:"addedTransitions"`
+
+	// RemovedTransitions contains the transitions only present in the old automaton.
+	RemovedTransitions transition.Transitions `json:"removedTransitions"`
+
+	// RetargetedTransitions contains the transitions present in both automata with a different result state.
+	RetargetedTransitions []Retarget `json:"retargetedTransitions"`
+
+	// AddedDefaults contains the default transitions only present in the new automaton, without input.
+	AddedDefaults transition.Transitions `json:"addedDefaults"`
+
+	// RemovedDefaults contains the default transitions only present in the old automaton, without input.
+	RemovedDefaults transition.Transitions `json:"removedDefaults"`
+
+	// RetargetedDefaults contains the default transitions present in both automata with a different result state.
+	RetargetedDefaults []Retarget `json:"retargetedDefaults"`
+
+	// AddedRanges contains the runes of range transitions only leading to their result state in the new automaton.
+	AddedRanges transition.RangeTransitions `json:"addedRanges"`
+
+	// RemovedRanges contains the runes of range transitions only leading to their result state in the old automaton.
+	// A retargeted rune is both removed and added.
+	RemovedRanges transition.RangeTransitions `json:"removedRanges"`
+
+	// LanguageChanged is true if the automata accept different inputs.
+	LanguageChanged bool `json:"languageChanged"`
+
+	// Witness is a shortest input accepted by exactly one of the automata.
+	Witness []string `json:"witness"`
+
+	// WitnessAccepted is true if the witness is accepted by the new automaton.
+	WitnessAccepted bool `json:"witnessAccepted"`
+}
+
+// Diff compares an old automaton a with a new automaton b.
+func Diff(a, b *FiniteAutomation) *DiffReport {
+	report := &DiffReport{
+		AddedStates:           make([]string, 0),
+		RemovedStates:         make([]string, 0),
+		FinalChanges:          make([]FinalChange, 0),
+		AddedTransitions:      make(transition.Transitions, 0),
+		RemovedTransitions:    make(transition.Transitions, 0),
+		RetargetedTransitions: make([]Retarget, 0),
+		AddedDefaults:         make(transition.Transitions, 0),
+		RemovedDefaults:       make(transition.Transitions, 0),
+		RetargetedDefaults:    make([]Retarget, 0),
+		AddedRanges:           make(transition.RangeTransitions, 0),
+		RemovedRanges:         make(transition.RangeTransitions, 0),
+	}
+
+	if a.InitialState.name != b.InitialState.name {
+		report.InitialState = []string{a.InitialState.name, b.InitialState.name}
+	}
+
+	// Compare states.
+	for _, state := range a.States {
+		other, err := b.States.Find(state.name)
+		if err != nil {
+			report.RemovedStates = append(report.RemovedStates, state.name)
+			continue
+		}
+
+		if other.final != state.final {
+			report.FinalChanges = append(report.FinalChanges, FinalChange{State: state.name, Final: other.final})
+		}
+	}
+
+	for _, state := range b.States {
+		if _, err := a.States.Find(state.name); err != nil {
+			report.AddedStates = append(report.AddedStates, state.name)
+		}
+	}
+
+	// Compare transitions.
+	type key struct {
+		start string
+		input string
+	}
+
+	oldResults := make(map[key]string)
+	for _, t := range a.Transitions() {
+		oldResults[key{t.StartState, t.Input}] = t.ResultState
+	}
+
+	newResults := make(map[key]string)
+	for _, t := range b.Transitions() {
+		newResults[key{t.StartState, t.Input}] = t.ResultState
+
+		old, ok := oldResults[key{t.StartState, t.Input}]
+		switch {
+		case !ok:
+			report.AddedTransitions = append(report.AddedTransitions, t)
+		case old != t.ResultState:
+			report.RetargetedTransitions = append(report.RetargetedTransitions, Retarget{
+				StartState: t.StartState,
+				Input:      t.Input,
+				From:       old,
+				To:         t.ResultState,
+			})
+		}
+	}
+
+	for _, t := range a.Transitions() {
+		if _, ok := newResults[key{t.StartState, t.Input}]; !ok {
+			report.RemovedTransitions = append(report.RemovedTransitions, t)
+		}
+	}
+
+	// Compare default transitions.
+	oldDefaults, newDefaults := a.defaults(), b.defaults()
+	for _, state := range b.States {
+		target, ok := newDefaults[state.name]
+		if !ok {
+			continue
+		}
+
+		old, ok := oldDefaults[state.name]
+		switch {
+		case !ok:
+			report.AddedDefaults = append(report.AddedDefaults, transition.Transition{StartState: state.name, ResultState: target})
+		case old != target:
+			report.RetargetedDefaults = append(report.RetargetedDefaults, Retarget{StartState: state.name, From: old, To: target})
+		}
+	}
+
+	for _, state := range a.States {
+		if target, ok := oldDefaults[state.name]; ok {
+			if _, ok := newDefaults[state.name]; !ok {
+				report.RemovedDefaults = append(report.RemovedDefaults, transition.Transition{StartState: state.name, ResultState: target})
+			}
+		}
+	}
+
+	// Compare range transitions.
+	report.AddedRanges = subtractRanges(b.RangeTransitions(), a.RangeTransitions())
+	report.RemovedRanges = subtractRanges(a.RangeTransitions(), b.RangeTransitions())
+
+	// Compare languages.
+	witness, changed := distinguish(a, b, func(acceptedByA, acceptedByB bool) bool {
+		return acceptedByA != acceptedByB
+	})
+	if changed {
+		report.LanguageChanged = true
+		report.Witness = witness
+		report.WitnessAccepted = accepts(b.run(witness))
+	}
+
+	return report
+}
+
+// IsEmpty returns true if the automata have no structural or behavioural differences.
+func (d *DiffReport) IsEmpty() bool {
+	return len(d.InitialState) == 0 &&
+		len(d.AddedStates) == 0 &&
+		len(d.RemovedStates) == 0 &&
+		len(d.FinalChanges) == 0 &&
+		len(d.AddedTransitions) == 0 &&
+		len(d.RemovedTransitions) == 0 &&
+		len(d.RetargetedTransitions) == 0 &&
+		len(d.AddedDefaults) == 0 &&
+		len(d.RemovedDefaults) == 0 &&
+		len(d.RetargetedDefaults) == 0 &&
+		len(d.AddedRanges) == 0 &&
+		len(d.RemovedRanges) == 0 &&
+		!d.LanguageChanged
+}
+
+// String formats the report for humans, one change per line.
+func (d *DiffReport) String() string {
+	var sb strings.Builder
+
+	if len(d.InitialState) == 2 {
+		fmt.Fprintf(&sb, "~ initial state %s -> %s\n", d.InitialState[0], d.InitialState[1])
+	}
+	for _, state := range d.AddedStates {
+		fmt.Fprintf(&sb, "+ state %s\n", state)
+	}
+	for _, state := range d.RemovedStates {
+		fmt.Fprintf(&sb, "- state %s\n", state)
+	}
+	for _, change := range d.FinalChanges {
+		fmt.Fprintf(&sb, "~ state %s final %t -> %t\n", change.State, !change.Final, change.Final)
+	}
+	for _, t := range d.AddedTransitions {
+		fmt.Fprintf(&sb, "+ transition %s --%s--> %s\n", t.StartState, t.Input, t.ResultState)
+	}
+	for _, t := range d.RemovedTransitions {
+		fmt.Fprintf(&sb, "- transition %s --%s--> %s\n", t.StartState, t.Input, t.ResultState)
+	}
+	for _, r := range d.RetargetedTransitions {
+		fmt.Fprintf(&sb, "~ transition %s --%s--> %s -> %s\n", r.StartState, r.Input, r.From, r.To)
+	}
+	for _, t := range d.AddedDefaults {
+		fmt.Fprintf(&sb, "+ default %s --> %s\n", t.StartState, t.ResultState)
+	}
+	for _, t := range d.RemovedDefaults {
+		fmt.Fprintf(&sb, "- default %s --> %s\n", t.StartState, t.ResultState)
+	}
+	for _, r := range d.RetargetedDefaults {
+		fmt.Fprintf(&sb, "~ default %s --> %s -> %s\n", r.StartState, r.From, r.To)
+	}
+	for _, t := range d.AddedRanges {
+		fmt.Fprintf(&sb, "+ range %s --%s--> %s\n", t.StartState, t.Runes, t.ResultState)
+	}
+	for _, t := range d.RemovedRanges {
+		fmt.Fprintf(&sb, "- range %s --%s--> %s\n", t.StartState, t.Runes, t.ResultState)
+	}
+
+	if !d.LanguageChanged {
+		sb.WriteString("language unchanged\n")
+		return sb.String()
+	}
+
+	accepted := "rejected"
+	if d.WitnessAccepted {
+		accepted = "accepted"
+	}
+	fmt.Fprintf(&sb, "language changed: input %q is now %s\n", d.Witness, accepted)
+
+	return sb.String()
+}
+
+// defaults maps the names of states with a default transition to the name of its target.
+func (fa *FiniteAutomation) defaults() map[string]string {
+	defaults := make(map[string]string)
+	for _, state := range fa.States {
+		if state.fallback != nil {
+			defaults[state.name] = state.fallback.name
+		}
+	}
+
+	return defaults
+}
+
+// subtractRanges returns the runes of the range transitions of a that don't lead to the same state in b.
+func subtractRanges(a, b transition.RangeTransitions) transition.RangeTransitions {
+	type key struct {
+		start  string
+		result string
+	}
+
+	runes := make(map[key]transition.RuneSet)
+	for _, t := range b {
+		k := key{t.StartState, t.ResultState}
+		runes[k] = runes[k].Union(t.Runes)
+	}
+
+	diff := make(transition.RangeTransitions, 0)
+	for _, t := range a {
+		if rest := t.Runes.Subtract(runes[key{t.StartState, t.ResultState}]); !rest.IsEmpty() {
+			diff = append(diff, transition.RangeTransition{StartState: t.StartState, Runes: rest, ResultState: t.ResultState})
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,146 @@
+package automaton_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	t.Run("no differences", func(t *testing.T) {
+		report := automaton.Diff(newModulo3(t), newModulo3(t))
+
+		assert.True(t, report.IsEmpty())
+		assert.False(t, report.LanguageChanged)
+		assert.Equal(t, "language unchanged\n", report.String())
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		report := automaton.Diff(newLowercase(t, ""), newLowercase(t, "q"))
+
+		assert.Equal(t, transition.RangeTransitions{}, report.AddedRanges)
+		assert.Equal(t, transition.RangeTransitions{
+			{StartState: "start", Runes: transition.Runes('q'), ResultState: "one"},
+		}, report.RemovedRanges)
+		assert.True(t, report.LanguageChanged)
+		assert.Equal(t, []string{"q"}, report.Witness)
+		assert.False(t, report.WitnessAccepted)
+	})
+
+	t.Run("retargeted range transitions", func(t *testing.T) {
+		a, b := newLowercase(t, ""), newLowercase(t, "")
+		require.NoError(t, b.States.SetRange("one", transition.Range('x', 'z'), "one"))
+
+		report := automaton.Diff(a, b)
+		assert.False(t, report.IsEmpty())
+		assert.Equal(t, transition.RangeTransitions{
+			{StartState: "one", Runes: transition.Range('x', 'z'), ResultState: "one"},
+		}, report.AddedRanges)
+		assert.Equal(t, transition.RangeTransitions{
+			{StartState: "one", Runes: transition.Range('x', 'z'), ResultState: "two"},
+		}, report.RemovedRanges)
+		assert.Equal(t, `+ range one --[x-z]--> one
+- range one --[x-z]--> two
+language changed: input ["a" "x" "a"] is now accepted
+`, report.String())
+	})
+
+	t.Run("structural and behavioural differences", func(t *testing.T) {
+		b, err := automaton.NewFiniteAutomation([]string{"S0", "S1", "S3"}, "S0", []string{"S0", "S1"}, transition.Transitions{
+			{StartState: "S0", Input: "0", ResultState: "S0"},
+			{StartState: "S0", Input: "1", ResultState: "S1"},
+			{StartState: "S1", Input: "0", ResultState: "S3"},
+			{StartState: "S1", Input: "1", ResultState: "S1"},
+		})
+		require.NoError(t, err)
+
+		report := automaton.Diff(newModulo3(t), b)
+		assert.False(t, report.IsEmpty())
+		assert.Equal(t, []string{"S3"}, report.AddedStates)
+		assert.Equal(t, []string{"S2"}, report.RemovedStates)
+		assert.Equal(t, []automaton.FinalChange{{State: "S1", Final: true}}, report.FinalChanges)
+		assert.Equal(t, transition.Transitions{}, report.AddedTransitions)
+		assert.Equal(t, transition.Transitions{
+			{StartState: "S2", Input: "0", ResultState: "S1"},
+			{StartState: "S2", Input: "1", ResultState: "S2"},
+		}, report.RemovedTransitions)
+		assert.Equal(t, []automaton.Retarget{
+			{StartState: "S1", Input: "0", From: "S2", To: "S3"},
+			{StartState: "S1", Input: "1", From: "S0", To: "S1"},
+		}, report.RetargetedTransitions)
+		assert.True(t, report.LanguageChanged)
+		assert.Equal(t, []string{"1"}, report.Witness)
+		assert.True(t, report.WitnessAccepted)
+		assert.Equal(t, `+ state S3
+- state S2
+~ state S1 final false -> true
+- transition S2 --0--> S1
+- transition S2 --1--> S2
+~ transition S1 --0--> S2 -> S3
+~ transition S1 --1--> S0 -> S1
+language changed: input ["1"] is now accepted
+`, report.String())
+	})
+
+	t.Run("default transitions", func(t *testing.T) {
+		a, b := newModulo3(t), newModulo3(t)
+		require.NoError(t, a.States.SetDefault("S0", "S1"))
+		require.NoError(t, a.States.SetDefault("S1", "S1"))
+		require.NoError(t, b.States.SetDefault("S1", "S2"))
+		require.NoError(t, b.States.SetDefault("S2", "S0"))
+
+		report := automaton.Diff(a, b)
+		assert.False(t, report.IsEmpty())
+		assert.Equal(t, transition.Transitions{{StartState: "S2", ResultState: "S0"}}, report.AddedDefaults)
+		assert.Equal(t, transition.Transitions{{StartState: "S0", ResultState: "S1"}}, report.RemovedDefaults)
+		assert.Equal(t, []automaton.Retarget{{StartState: "S1", From: "S1", To: "S2"}}, report.RetargetedDefaults)
+
+		// The automata have a transition for every input, so default transitions don't change the language.
+		assert.False(t, report.LanguageChanged)
+		assert.Equal(t, `+ default S2 --> S0
+- default S0 --> S1
+~ default S1 --> S1 -> S2
+language unchanged
+`, report.String())
+	})
+
+	t.Run("empty witness", func(t *testing.T) {
+		b, err := automaton.NewFiniteAutomation([]string{"S0", "S1", "S2"}, "S1", []string{"S0"}, transition.Transitions{
+			{StartState: "S0", Input: "1", ResultState: "S1"},
+		})
+		require.NoError(t, err)
+
+		report := automaton.Diff(newModulo3(t), b)
+		assert.Equal(t, []string{"S0", "S1"}, report.InitialState)
+		assert.True(t, report.LanguageChanged)
+		assert.Equal(t, []string{}, report.Witness)
+		assert.False(t, report.WitnessAccepted)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		report := automaton.Diff(newModulo3(t), newModulo3(t))
+
+		data, err := json.Marshal(report)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"addedStates": [],
+			"removedStates": [],
+			"finalChanges": [],
+			"addedTransitions": [],
+			"removedTransitions": [],
+			"retargetedTransitions": [],
+			"addedDefaults": [],
+			"removedDefaults": [],
+			"retargetedDefaults": [],
+			"addedRanges": [],
+			"removedRanges": [],
+			"languageChanged": false,
+			"witness": null,
+			"witnessAccepted": false
+		}`, string(data))
+	})
+}
@@ -0,0 +1,109 @@
+package automaton
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+// StateNames returns the names of all states, in declaration order.
+func (fa *FiniteAutomation) StateNames() []string {
+	names := make([]string, 0, len(fa.States))
+	for state := range fa.AllStates() {
+		names = append(names, state.name)
+	}
+
+	return names
+}
+
+// FinalStates returns the names of the final states, in declaration order.
+func (fa *FiniteAutomation) FinalStates() []string {
+	names := make([]string, 0)
+	for state := range fa.AllStates() {
+		if state.final {
+			names = append(names, state.name)
+		}
+	}
+
+	return names
+}
+
+// Alphabet returns the valid inputs of the automation.
+func (fa *FiniteAutomation) Alphabet() []string {
+	return slices.Clone(fa.TransitionInputs)
+}
+
+// Transitions collects the transitions of all states, ordered by state and input.
+// Default transitions are not included, see State.Default.
+func (fa *FiniteAutomation) Transitions() transition.Transitions {
+	transitions := make(transition.Transitions, 0)
+	for t := range fa.Edges() {
+		transitions = append(transitions, t)
+	}
+
+	return transitions
+}
+
+// Successors returns the names of the states the state has a transition to, including its default transition.
+func (fa *FiniteAutomation) Successors(state string) ([]string, error) {
+	s, err := fa.States.Find(state)
+	if err != nil {
+		return nil, fmt.Errorf("%w - %s", err, state)
+	}
+
+	names := make([]string, 0)
+	for _, next := range fa.successors(s) {
+		names = append(names, next.name)
+	}
+
+	return names, nil
+}
+
+// Predecessors returns the names of the states that have a transition to the state, including default transitions.
+func (fa *FiniteAutomation) Predecessors(state string) ([]string, error) {
+	s, err := fa.States.Find(state)
+	if err != nil {
+		return nil, fmt.Errorf("%w - %s", err, state)
+	}
+
+	names := make([]string, 0)
+	for previous := range fa.AllStates() {
+		if slices.Contains(fa.successors(previous), s) {
+			names = append(names, previous.name)
+		}
+	}
+
+	return names, nil
+}
+
+// AllStates returns an iterator over all states, in declaration order.
+func (fa *FiniteAutomation) AllStates() iter.Seq[*State] {
+	return func(yield func(*State) bool) {
+		for _, state := range fa.States {
+			if !yield(state) {
+				return
+			}
+		}
+	}
+}
+
+// Edges returns an iterator over the transitions of all states, ordered by state and input.
+// Default transitions are not included, see State.Default.
+func (fa *FiniteAutomation) Edges() iter.Seq[transition.Transition] {
+	return func(yield func(transition.Transition) bool) {
+		for _, state := range fa.States {
+			for _, input := range state.inputs(fa.TransitionInputs) {
+				t := transition.Transition{
+					StartState:  state.name,
+					Input:       input,
+					ResultState: state.delta[input].name,
+				}
+				if !yield(t) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package automaton_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFiniteAutomation_StateNames(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, []string{"S0", "S1", "S2"}, newModulo3(t).StateNames())
+	})
+}
+
+func TestFiniteAutomation_FinalStates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, []string{"f"}, newWithUselessStates(t).FinalStates())
+	})
+}
+
+func TestFiniteAutomation_Alphabet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newModulo3(t)
+		alphabet := fa.Alphabet()
+		assert.Equal(t, []string{"0", "1"}, alphabet)
+
+		// The alphabet is a copy.
+		alphabet[0] = "2"
+		assert.Equal(t, []string{"0", "1"}, fa.TransitionInputs)
+	})
+}
+
+func TestFiniteAutomation_Successors(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newWithUselessStates(t)
+
+		successors, err := fa.Successors("s")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "d"}, successors)
+
+		successors, err = fa.Successors("f")
+		assert.NoError(t, err)
+		assert.Empty(t, successors)
+	})
+
+	t.Run("default transition", func(t *testing.T) {
+		fa := newPartial(t)
+		require.NoError(t, fa.States.SetDefault("f", "s"))
+
+		successors, err := fa.Successors("f")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"s"}, successors)
+	})
+
+	t.Run("state not found", func(t *testing.T) {
+		_, err := newModulo3(t).Successors("S3")
+		assert.ErrorIs(t, err, automaton.ErrStateNotFound)
+	})
+}
+
+func TestFiniteAutomation_Predecessors(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newWithUselessStates(t)
+
+		predecessors, err := fa.Predecessors("f")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "u"}, predecessors)
+
+		predecessors, err = fa.Predecessors("d")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"s", "d"}, predecessors)
+	})
+
+	t.Run("state not found", func(t *testing.T) {
+		_, err := newModulo3(t).Predecessors("S3")
+		assert.ErrorIs(t, err, automaton.ErrStateNotFound)
+	})
+}
+
+func TestFiniteAutomation_AllStates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newModulo3(t)
+
+		assert.Equal(t, []*automaton.State(fa.States), slices.Collect(fa.AllStates()))
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+		count := 0
+		for range newModulo3(t).AllStates() {
+			count++
+			break
+		}
+
+		assert.Equal(t, 1, count)
+	})
+}
+
+func TestFiniteAutomation_Edges(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newPartial(t)
+
+		assert.Equal(t, []transition.Transition{
+			{StartState: "s", Input: "a", ResultState: "a"},
+			{StartState: "a", Input: "b", ResultState: "f"},
+		}, slices.Collect(fa.Edges()))
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+		count := 0
+		for range newModulo3(t).Edges() {
+			count++
+			break
+		}
+
+		assert.Equal(t, 1, count)
+	})
+}
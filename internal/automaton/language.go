@@ -0,0 +1,267 @@
+package automaton
+
+import (
+	"math/big"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+// IsEmpty returns true if the automation accepts no input.
+func (fa *FiniteAutomation) IsEmpty() bool {
+	reachable := fa.reachable()
+	for state := range reachable {
+		if state.final {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsUniversal returns true if the automation accepts every input over the alphabet.
+// A nil alphabet means the inputs of the automation, including the runes of range transitions.
+func (fa *FiniteAutomation) IsUniversal(alphabet []string) bool {
+	if alphabet == nil {
+		alphabet = make([]string, 0)
+		for _, sym := range symbols(fa) {
+			alphabet = append(alphabet, sym.input)
+		}
+	}
+
+	visited := map[*State]bool{fa.InitialState: true}
+	queue := States{fa.InitialState}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		if !state.final {
+			return false
+		}
+
+		for _, input := range alphabet {
+			next := fa.next(state, input)
+			if next == nil {
+				return false
+			}
+
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return true
+}
+
+// IsFinite returns true if the automation accepts finitely many inputs,
+// that is if there is no cycle through states that are both reachable and can reach a final state.
+func (fa *FiniteAutomation) IsFinite() bool {
+	useful := fa.useful()
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	colors := make(map[*State]int)
+	var hasCycle func(*State) bool
+	hasCycle = func(state *State) bool {
+		colors[state] = visiting
+		for _, next := range fa.successors(state) {
+			if !useful[next] {
+				continue
+			}
+
+			switch colors[next] {
+			case visiting:
+				return true
+			case unvisited:
+				if hasCycle(next) {
+					return true
+				}
+			}
+		}
+		colors[state] = done
+
+		return false
+	}
+
+	for _, state := range fa.States {
+		if useful[state] && colors[state] == unvisited && hasCycle(state) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Count returns the number of accepted inputs of exactly length n.
+func (fa *FiniteAutomation) Count(n int) *big.Int {
+	if n < 0 {
+		return new(big.Int)
+	}
+
+	counts := fa.countFrom(n)
+
+	return new(big.Int).Set(counts[n][fa.InitialState])
+}
+
+// countFrom returns, for every length k up to n and every state, the number of inputs of length k
+// leading from the state to a final state.
+func (fa *FiniteAutomation) countFrom(n int) []map[*State]*big.Int {
+	syms := symbols(fa)
+	counts := make([]map[*State]*big.Int, n+1)
+	for k := 0; k <= n; k++ {
+		counts[k] = make(map[*State]*big.Int, len(fa.States))
+		for _, state := range fa.States {
+			count := new(big.Int)
+			if k == 0 {
+				if state.final {
+					count.SetInt64(1)
+				}
+			} else {
+				for _, sym := range syms {
+					if next := state.move(sym.input, true); next != nil {
+						count.Add(count, sym.times(counts[k-1][next]))
+					}
+				}
+			}
+			counts[k][state] = count
+		}
+	}
+
+	return counts
+}
+
+// alphabet returns the inputs of the automation without duplicates.
+func (fa *FiniteAutomation) alphabet() []string {
+	alphabet := make([]string, 0, len(fa.TransitionInputs))
+	for _, input := range fa.TransitionInputs {
+		if !slices.Contains(alphabet, input) {
+			alphabet = append(alphabet, input)
+		}
+	}
+
+	return alphabet
+}
+
+// symbol is an input of the language queries: an input of the alphabet, or a class of runes of range
+// transitions outside of the alphabet. Every state handles the runes of a class alike, so the first
+// rune stands for the class.
+type symbol struct {
+	// input is the input, or the first rune of the class.
+	input string
+
+	// class contains the runes of the class, it's nil for inputs of the alphabet.
+	class transition.RuneSet
+}
+
+// size returns the number of inputs the symbol stands for.
+func (sym symbol) size() int {
+	if sym.class == nil {
+		return 1
+	}
+
+	return int(sym.class[0].Hi-sym.class[0].Lo) + 1
+}
+
+// at returns the i-th input the symbol stands for.
+func (sym symbol) at(i int) string {
+	if sym.class == nil {
+		return sym.input
+	}
+
+	return string(sym.class[0].Lo + rune(i))
+}
+
+// times returns count multiplied by the size of the symbol.
+func (sym symbol) times(count *big.Int) *big.Int {
+	if sym.class == nil {
+		return count
+	}
+
+	return new(big.Int).Mul(count, big.NewInt(int64(sym.size())))
+}
+
+// symbols returns the inputs of the automata without duplicates, followed by the classes of the runes
+// of their range transitions in ascending order. Runes that are inputs of the alphabet or have a
+// transition as a single input are left out of larger classes, and surrogates are left out entirely.
+func symbols(fas ...*FiniteAutomation) []symbol {
+	syms := make([]symbol, 0)
+	seen := make(map[string]bool)
+	for _, fa := range fas {
+		for _, input := range fa.alphabet() {
+			if !seen[input] {
+				seen[input] = true
+				syms = append(syms, symbol{input: input})
+			}
+		}
+	}
+
+	// Classes are delimited by the bounds of range transitions, and runes handled on their own.
+	ranges := make([]transition.RuneRange, 0)
+	bounds := []rune{0xD800, 0xE000}
+	alone := make([]rune, 0)
+	for _, fa := range fas {
+		for _, state := range fa.States {
+			for _, edge := range state.ranges {
+				ranges = append(ranges, edge.RuneRange)
+				bounds = append(bounds, edge.Lo, edge.Hi+1)
+			}
+			for input := range state.delta {
+				if r, ok := singleRune(input); ok {
+					alone = append(alone, r)
+				}
+			}
+		}
+	}
+	for input := range seen {
+		if r, ok := singleRune(input); ok {
+			alone = append(alone, r)
+		}
+	}
+
+	covered := transition.NewRuneSet(ranges...)
+	for _, r := range alone {
+		if covered.Contains(r) {
+			bounds = append(bounds, r, r+1)
+		}
+	}
+	slices.Sort(bounds)
+	bounds = slices.Compact(bounds)
+
+	for i := 0; i+1 < len(bounds); i++ {
+		lo, hi := bounds[i], bounds[i+1]-1
+		if !covered.Contains(lo) || !utf8.ValidRune(lo) || (lo == hi && seen[string(lo)]) {
+			continue
+		}
+		syms = append(syms, symbol{input: string(lo), class: transition.RuneSet{{Lo: lo, Hi: hi}}})
+	}
+
+	return syms
+}
+
+// isInput returns true if the input is in the alphabet or a rune of a range transition.
+func (fa *FiniteAutomation) isInput(input string) bool {
+	return slices.Contains(fa.TransitionInputs, input) || fa.inRanges(input)
+}
+
+// inRanges returns true if the input is a rune of a range transition.
+func (fa *FiniteAutomation) inRanges(input string) bool {
+	r, ok := singleRune(input)
+	if !ok {
+		return false
+	}
+
+	for _, state := range fa.States {
+		if state.rangeTarget(r) != nil {
+			return true
+		}
+	}
+
+	return false
+}
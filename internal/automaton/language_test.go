@@ -0,0 +1,127 @@
+package automaton_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFiniteAutomation_IsEmpty(t *testing.T) {
+	t.Run("not empty", func(t *testing.T) {
+		assert.False(t, newModulo3(t).IsEmpty())
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		assert.False(t, newLowercase(t, "").IsEmpty())
+	})
+
+	t.Run("unreachable final state", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s", "f"}, "s", []string{"f"}, transition.Transitions{
+			{StartState: "s", Input: "a", ResultState: "s"},
+			{StartState: "f", Input: "a", ResultState: "s"},
+		})
+		require.NoError(t, err)
+
+		assert.True(t, fa.IsEmpty())
+	})
+}
+
+func TestFiniteAutomation_IsUniversal(t *testing.T) {
+	t.Run("universal", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s", "f"}, "s", []string{"s", "f"}, transition.Transitions{
+			{StartState: "s", Input: "a", ResultState: "f"},
+			{StartState: "s", Input: "b", ResultState: "s"},
+			{StartState: "f", Input: "a", ResultState: "s"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, fa.States.SetDefault("f", "f"))
+
+		assert.True(t, fa.IsUniversal(nil))
+		assert.False(t, fa.IsUniversal([]string{"a", "b", "c"}))
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s"}, "s", []string{"s"}, nil)
+		require.NoError(t, err)
+		require.NoError(t, fa.States.SetRange("s", transition.Range('a', 'z'), "s"))
+
+		assert.True(t, fa.IsUniversal(nil))
+		assert.False(t, newLowercase(t, "").IsUniversal(nil))
+	})
+
+	t.Run("not universal", func(t *testing.T) {
+		assert.False(t, newModulo3(t).IsUniversal(nil))
+		assert.False(t, newPartial(t).IsUniversal(nil))
+	})
+}
+
+func TestFiniteAutomation_IsFinite(t *testing.T) {
+	t.Run("finite", func(t *testing.T) {
+		// The sink state has a cycle, but can't reach a final state.
+		assert.True(t, newWithUselessStates(t).IsFinite())
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		assert.True(t, newLowercase(t, "").IsFinite())
+		assert.False(t, newIdentifier(t).IsFinite())
+	})
+
+	t.Run("infinite", func(t *testing.T) {
+		assert.False(t, newModulo3(t).IsFinite())
+	})
+}
+
+func TestFiniteAutomation_Count(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newModulo3(t)
+
+		// Binary numbers of length n divisible by 3, including leading zeros.
+		assert.Equal(t, big.NewInt(1), fa.Count(0))
+		assert.Equal(t, big.NewInt(1), fa.Count(1))
+		assert.Equal(t, big.NewInt(2), fa.Count(2))
+		assert.Equal(t, big.NewInt(3), fa.Count(3))
+		assert.Equal(t, big.NewInt(6), fa.Count(4))
+		assert.Equal(t, big.NewInt(0), fa.Count(-1))
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		fa := newLowercase(t, "")
+		assert.Equal(t, big.NewInt(26), fa.Count(1))
+		assert.Equal(t, big.NewInt(26*26), fa.Count(2))
+		assert.Equal(t, big.NewInt(0), fa.Count(3))
+
+		// The transition for q as a single input takes precedence over the range.
+		require.NoError(t, fa.States.SetDelta("start", "q", "start"))
+		fa.TransitionInputs = []string{"q"}
+		assert.Equal(t, big.NewInt(25), fa.Count(1))
+		assert.Equal(t, big.NewInt(25*26+25), fa.Count(2))
+	})
+
+	t.Run("big numbers", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s"}, "s", []string{"s"}, transition.Transitions{
+			{StartState: "s", Input: "a", ResultState: "s"},
+			{StartState: "s", Input: "b", ResultState: "s"},
+		})
+		require.NoError(t, err)
+
+		expected := new(big.Int).Lsh(big.NewInt(1), 100)
+		assert.Equal(t, 0, expected.Cmp(fa.Count(100)))
+	})
+
+	t.Run("default transitions", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s", "f"}, "s", []string{"f"}, transition.Transitions{
+			{StartState: "s", Input: "a", ResultState: "s"},
+		})
+		require.NoError(t, err)
+		require.NoError(t, fa.States.SetDefault("s", "f"))
+		fa.TransitionInputs = []string{"a", "b", "c"}
+
+		// "b" and "c" use the default transition.
+		assert.Equal(t, big.NewInt(2), fa.Count(1))
+		assert.Equal(t, big.NewInt(2), fa.Count(2))
+	})
+}
@@ -0,0 +1,211 @@
+package automaton
+
+import (
+	"fmt"
+
+	"github.com/amitprajapati027/finite-automation/internal/validation"
+)
+
+const (
+	// DefaultCacheSize is the memory budget of a lazy DFA's state cache, in bytes.
+	DefaultCacheSize = 1 << 20
+
+	// DefaultMinInputsPerState is the least number of inputs per cached state a lazy DFA
+	// must consume between two cache flushes to keep using the cache.
+	DefaultMinInputsPerState = 10
+
+	// lazyStateCost approximates the memory of a cached state, besides its NFA states.
+	lazyStateCost = 64
+
+	// lazyTransitionCost approximates the memory of a cached transition, besides its input.
+	lazyTransitionCost = 48
+)
+
+// LazyOptions configures a LazyDFA.
+type LazyOptions struct {
+	// CacheSize is the memory budget of the state cache in bytes, DefaultCacheSize if zero.
+	CacheSize int
+
+	// MinInputsPerState is the least number of inputs per cached state to consume between two
+	// cache flushes, DefaultMinInputsPerState if zero. When the cache fills up sooner, it's
+	// thrashing, and the rest of the run falls back to NFA simulation.
+	MinInputsPerState int
+}
+
+// LazyStats describes the work done by a LazyDFA.
+type LazyStats struct {
+	// CachedStates is the number of states in the cache.
+	CachedStates int
+
+	// CacheBytes is the approximate memory used by the cache.
+	CacheBytes int
+
+	// CreatedStates is the number of states created, including flushed ones.
+	CreatedStates int
+
+	// Flushes is the number of times the cache was full and cleared.
+	Flushes int
+
+	// Fallbacks is the number of runs that fell back to NFA simulation.
+	Fallbacks int
+}
+
+// LazyDFA executes an NFA, creating the states of the subset construction when they're first needed.
+// States are cached within a memory budget, and the cache is cleared when full.
+// A LazyDFA is not safe for concurrent use.
+type LazyDFA struct {
+	// nfa is the executed NFA.
+	nfa *NFA
+
+	// options contains the cache configuration.
+	options LazyOptions
+
+	// cache maps the keys of NFA state sets to cached states.
+	cache map[string]*lazyState
+
+	// start is the cached initial state, nil if not cached.
+	start *lazyState
+
+	// consumed is the number of inputs consumed since the cache was last flushed.
+	consumed int
+
+	// stats describes the work done so far.
+	stats LazyStats
+}
+
+// lazyState is a cached state of a LazyDFA.
+type lazyState struct {
+	// set contains the NFA states.
+	set stateSet
+
+	// final is true if the set contains a final state.
+	final bool
+
+	// next contains the cached transitions.
+	next map[string]*lazyState
+}
+
+// Lazy returns a LazyDFA executing the NFA.
+func (n *NFA) Lazy(options LazyOptions) *LazyDFA {
+	if options.CacheSize <= 0 {
+		options.CacheSize = DefaultCacheSize
+	}
+	if options.MinInputsPerState <= 0 {
+		options.MinInputsPerState = DefaultMinInputsPerState
+	}
+
+	l := &LazyDFA{nfa: n, options: options}
+	l.flush()
+
+	return l
+}
+
+// Stats returns the work done by the lazy DFA so far.
+func (l *LazyDFA) Stats() LazyStats {
+	return l.stats
+}
+
+// Accepts returns true if the NFA accepts the inputs.
+// Only inputs outside of the alphabet return an error.
+func (l *LazyDFA) Accepts(Sigma ...string) (bool, error) {
+	err := validation.ValidateInputs(Sigma, l.nfa.alphabet)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute lazy dfa: %w", err)
+	}
+
+	if l.start == nil {
+		initial := l.nfa.closure(l.nfa.initial)
+		start, ok := l.add(initial, 0)
+		if !ok {
+			// A run flushed the cache and filled it again, start over with the initial state.
+			l.flush()
+			l.stats.Flushes++
+			start, _ = l.add(initial, 0)
+		}
+		l.start = start
+	}
+
+	current := l.start
+	for i, input := range Sigma {
+		if len(current.set) == 0 {
+			return false, nil
+		}
+
+		next, ok := current.next[input]
+		if !ok {
+			set := l.nfa.step(current.set, input)
+			cost := lazyTransitionCost + len(input)
+			next, ok = l.add(set, cost)
+			if !ok {
+				if l.consumed < l.options.MinInputsPerState*len(l.cache) {
+					// The cache is thrashing, simulate the NFA for the rest of the inputs.
+					l.stats.Fallbacks++
+					return l.simulate(set, Sigma[i+1:]), nil
+				}
+
+				l.flush()
+				l.stats.Flushes++
+				next, _ = l.add(set, cost)
+			}
+
+			current.next[input] = next
+		}
+
+		current = next
+		l.consumed++
+	}
+
+	return current.final, nil
+}
+
+// add returns the cached state for the set, caching it if there's room for it and extra bytes.
+// An empty cache always has room.
+func (l *LazyDFA) add(set stateSet, extra int) (*lazyState, bool) {
+	key := set.key()
+	state, ok := l.cache[key]
+	cost := extra
+	if !ok {
+		cost += lazyStateCost + len(key) + 8*len(set)
+	}
+
+	if len(l.cache) > 0 && l.stats.CacheBytes+cost > l.options.CacheSize {
+		return nil, false
+	}
+
+	l.stats.CacheBytes += cost
+	if ok {
+		return state, true
+	}
+
+	state = &lazyState{
+		set:   set,
+		final: l.nfa.accepting(set),
+		next:  make(map[string]*lazyState),
+	}
+	l.cache[key] = state
+	l.stats.CachedStates++
+	l.stats.CreatedStates++
+
+	return state, true
+}
+
+// flush clears the cache.
+func (l *LazyDFA) flush() {
+	l.cache = make(map[string]*lazyState)
+	l.start = nil
+	l.consumed = 0
+	l.stats.CachedStates = 0
+	l.stats.CacheBytes = 0
+}
+
+// simulate returns true if the NFA accepts the inputs from the set of states.
+func (l *LazyDFA) simulate(set stateSet, Sigma []string) bool {
+	for _, input := range Sigma {
+		if len(set) == 0 {
+			return false
+		}
+		set = l.nfa.step(set, input)
+	}
+
+	return l.nfa.accepting(set)
+}
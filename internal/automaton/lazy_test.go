@@ -0,0 +1,154 @@
+package automaton_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/internal/validation"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newNthFromEnd builds an NFA accepting inputs over "a" and "b" whose n-th symbol from the end is "a".
+// Its subset construction has 2^n states.
+func newNthFromEnd(t *testing.T, n int) *automaton.NFA {
+	t.Helper()
+
+	states := make([]string, n+1)
+	for i := range states {
+		states[i] = fmt.Sprintf("q%d", i)
+	}
+
+	transitions := transition.Transitions{
+		{StartState: "q0", Input: "a", ResultState: "q0"},
+		{StartState: "q0", Input: "b", ResultState: "q0"},
+		{StartState: "q0", Input: "a", ResultState: "q1"},
+	}
+	for i := 1; i < n; i++ {
+		transitions = append(transitions,
+			transition.Transition{StartState: states[i], Input: "a", ResultState: states[i+1]},
+			transition.Transition{StartState: states[i], Input: "b", ResultState: states[i+1]},
+		)
+	}
+
+	nfa, err := automaton.NewNFA(states, []string{"q0"}, []string{states[n]}, transitions)
+	require.NoError(t, err)
+
+	return nfa
+}
+
+// randomInput returns an input of length symbols over "a" and "b".
+func randomInput(rng *rand.Rand, length int) []string {
+	input := make([]string, length)
+	for i := range input {
+		input[i] = []string{"a", "b"}[rng.IntN(2)]
+	}
+
+	return input
+}
+
+func TestNFA_Lazy(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		lazy := newEndsWithAB(t).Lazy(automaton.LazyOptions{})
+
+		tests := map[string]bool{"": false, "ab": true, "bab": true, "aba": false, "b": false}
+		for input, expected := range tests {
+			ok, err := lazy.Accepts(strings.Split(input, "")...)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, ok, input)
+		}
+
+		stats := lazy.Stats()
+		assert.Equal(t, 3, stats.CachedStates)
+		assert.Equal(t, 3, stats.CreatedStates)
+		assert.Zero(t, stats.Flushes)
+		assert.Zero(t, stats.Fallbacks)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := newEndsWithAB(t).Lazy(automaton.LazyOptions{}).Accepts("a", "c")
+		assert.ErrorIs(t, err, validation.ErrInvalidInput)
+	})
+
+	t.Run("reuses cached states", func(t *testing.T) {
+		lazy := newNthFromEnd(t, 3).Lazy(automaton.LazyOptions{})
+		rng := rand.New(rand.NewPCG(1, 2))
+
+		_, err := lazy.Accepts(randomInput(rng, 200)...)
+		require.NoError(t, err)
+		created := lazy.Stats().CreatedStates
+		assert.Equal(t, 8, created)
+
+		_, err = lazy.Accepts(randomInput(rng, 200)...)
+		require.NoError(t, err)
+		assert.Equal(t, created, lazy.Stats().CreatedStates)
+	})
+
+	t.Run("flushes full cache", func(t *testing.T) {
+		nfa := newNthFromEnd(t, 6)
+		lazy := nfa.Lazy(automaton.LazyOptions{CacheSize: 4096, MinInputsPerState: 1})
+		rng := rand.New(rand.NewPCG(1, 2))
+
+		for range 20 {
+			input := randomInput(rng, 500)
+			expected, err := nfa.Accepts(input...)
+			require.NoError(t, err)
+
+			ok, err := lazy.Accepts(input...)
+			require.NoError(t, err)
+			assert.Equal(t, expected, ok)
+		}
+
+		stats := lazy.Stats()
+		assert.Positive(t, stats.Flushes)
+		assert.Zero(t, stats.Fallbacks)
+		assert.LessOrEqual(t, stats.CacheBytes, 4096)
+	})
+
+	t.Run("start state after a flush", func(t *testing.T) {
+		nfa, err := automaton.NewNFA([]string{"s", "a", "b", "c"}, []string{"s"}, []string{"c"}, transition.Transitions{
+			{StartState: "s", Input: "x", ResultState: "a"},
+			{StartState: "a", Input: "x", ResultState: "s"},
+			{StartState: "a", Input: "y", ResultState: "b"},
+			{StartState: "b", Input: "y", ResultState: "c"},
+		})
+		require.NoError(t, err)
+		lazy := nfa.Lazy(automaton.LazyOptions{CacheSize: 250, MinInputsPerState: 1})
+
+		// The run flushes the cache, then fills it without the initial state.
+		ok, err := lazy.Accepts("x", "x", "x", "x", "x", "x", "x", "y", "y")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		require.Positive(t, lazy.Stats().Flushes)
+
+		ok, err = lazy.Accepts("x")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		ok, err = lazy.Accepts("x", "y", "y")
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("falls back when thrashing", func(t *testing.T) {
+		nfa := newNthFromEnd(t, 12)
+		lazy := nfa.Lazy(automaton.LazyOptions{CacheSize: 4096})
+		rng := rand.New(rand.NewPCG(1, 2))
+
+		for range 20 {
+			input := randomInput(rng, 500)
+			expected, err := nfa.Accepts(input...)
+			require.NoError(t, err)
+
+			ok, err := lazy.Accepts(input...)
+			require.NoError(t, err)
+			assert.Equal(t, expected, ok)
+		}
+
+		assert.Positive(t, lazy.Stats().Fallbacks)
+	})
+}
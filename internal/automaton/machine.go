@@ -0,0 +1,119 @@
+package automaton
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrSnapshotMismatch = errors.New("error snapshot was taken against a different automaton definition")
+)
+
+// Machine is a running instance of a finite automation.
+// It keeps track of the current state while inputs are fed one at a time.
+type Machine struct {
+	// automaton is the definition the machine runs.
+	automaton *FiniteAutomation
+
+	// state is the current state.
+	state *State
+
+	// steps is the number of inputs consumed so far.
+	steps int
+}
+
+// Snapshot records the progress of a machine, so it can be persisted and restored later.
+type Snapshot struct {
+	// State is the name of the current state.
+	State string `json:"state"`
+
+	// Steps is the number of inputs consumed.
+	Steps int `json:"steps"`
+
+	// Fingerprint identifies the automaton definition the snapshot was taken against.
+	Fingerprint string `json:"fingerprint"`
+}
+
+// NewMachine creates a new machine placed in the initial state.
+func (fa *FiniteAutomation) NewMachine() *Machine {
+	return &Machine{
+		automaton: fa,
+		state:     fa.InitialState,
+	}
+}
+
+// Automaton returns the automation the machine runs.
+func (m *Machine) Automaton() *FiniteAutomation {
+	return m.automaton
+}
+
+// State returns the current state.
+func (m *Machine) State() *State {
+	return m.state
+}
+
+// Steps returns the number of inputs consumed so far.
+func (m *Machine) Steps() int {
+	return m.steps
+}
+
+// IsAccepting returns true if the current state is a final state.
+func (m *Machine) IsAccepting() bool {
+	return m.state.IsFinal()
+}
+
+// Step feeds the inputs to the machine. Inputs made of a single rune also take range transitions,
+// and the runes of range transitions are valid inputs, as in Accepts.
+// The machine is left unchanged if any of the inputs can't be consumed.
+func (m *Machine) Step(Sigma ...string) error {
+	err := m.automaton.validateInputs(Sigma)
+	if err != nil {
+		return fmt.Errorf("failed to step machine: %w", err)
+	}
+
+	state := m.state
+	for _, s := range Sigma {
+		state = m.automaton.next(state, s)
+		if state == nil {
+			return fmt.Errorf("error stepping machine: %w", ErrStateTransitionNotFound)
+		}
+	}
+
+	m.state = state
+	m.steps += len(Sigma)
+
+	return nil
+}
+
+// Reset places the machine back in the initial state.
+func (m *Machine) Reset() {
+	m.state = m.automaton.InitialState
+	m.steps = 0
+}
+
+// Snapshot records the current state, step count and definition fingerprint.
+func (m *Machine) Snapshot() Snapshot {
+	return Snapshot{
+		State:       m.state.GetName(),
+		Steps:       m.steps,
+		Fingerprint: m.automaton.Fingerprint(),
+	}
+}
+
+// Restore places the machine in the state recorded by the snapshot.
+// Snapshots taken against a different automaton definition are refused.
+func (m *Machine) Restore(snapshot Snapshot) error {
+	if snapshot.Fingerprint != m.automaton.Fingerprint() {
+		return fmt.Errorf("%w - %s", ErrSnapshotMismatch, snapshot.Fingerprint)
+	}
+
+	state, err := m.automaton.States.Find(snapshot.State)
+	if err != nil {
+		return fmt.Errorf("error restoring state %s: %w", snapshot.State, err)
+	}
+
+	m.state = state
+	m.steps = snapshot.Steps
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package automaton_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/internal/validation"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newModulo3 builds an automation that accepts binary numbers divisible by 3.
+func newModulo3(t *testing.T) *automaton.FiniteAutomation {
+	t.Helper()
+
+	fa, err := automaton.NewFiniteAutomation([]string{"S0", "S1", "S2"}, "S0", []string{"S0"}, transition.Transitions{
+		{StartState: "S0", Input: "0", ResultState: "S0"},
+		{StartState: "S0", Input: "1", ResultState: "S1"},
+		{StartState: "S1", Input: "0", ResultState: "S2"},
+		{StartState: "S1", Input: "1", ResultState: "S0"},
+		{StartState: "S2", Input: "0", ResultState: "S1"},
+		{StartState: "S2", Input: "1", ResultState: "S2"},
+	})
+	require.NoError(t, err)
+
+	return fa
+}
+
+func TestFiniteAutomation_NewMachine(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newModulo3(t)
+		m := fa.NewMachine()
+
+		assert.Equal(t, fa, m.Automaton())
+		assert.Equal(t, fa.InitialState, m.State())
+		assert.Zero(t, m.Steps())
+		assert.True(t, m.IsAccepting())
+	})
+}
+
+func TestMachine_Step(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m := newModulo3(t).NewMachine()
+
+		assert.NoError(t, m.Step("1", "0"))
+		assert.NoError(t, m.Step("0"))
+		assert.Equal(t, "S1", m.State().GetName())
+		assert.Equal(t, 3, m.Steps())
+		assert.False(t, m.IsAccepting())
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		m := newModulo3(t).NewMachine()
+
+		err := m.Step("1", "2")
+		assert.ErrorIs(t, err, validation.ErrInvalidInput)
+		assert.Equal(t, "S0", m.State().GetName())
+		assert.Zero(t, m.Steps())
+	})
+
+	t.Run("transition not found", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s1", "s2"}, "s1", []string{"s2"}, transition.Transitions{
+			{StartState: "s1", Input: "0", ResultState: "s2"},
+		})
+		require.NoError(t, err)
+		m := fa.NewMachine()
+
+		err = m.Step("0", "0")
+		assert.ErrorIs(t, err, automaton.ErrStateTransitionNotFound)
+		assert.Equal(t, "s1", m.State().GetName())
+		assert.Zero(t, m.Steps())
+	})
+	t.Run("range transitions", func(t *testing.T) {
+		m := newLowercase(t, "q").NewMachine()
+
+		assert.NoError(t, m.Step("a", "z"))
+		assert.Equal(t, "two", m.State().GetName())
+		assert.True(t, m.IsAccepting())
+
+		m.Reset()
+		err := m.Step("q")
+		assert.ErrorIs(t, err, automaton.ErrStateTransitionNotFound)
+		assert.Equal(t, "start", m.State().GetName())
+
+		err = m.Step("A")
+		assert.ErrorIs(t, err, validation.ErrInvalidInput)
+	})
+}
+
+func TestMachine_Reset(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m := newModulo3(t).NewMachine()
+		assert.NoError(t, m.Step("1"))
+
+		m.Reset()
+		assert.Equal(t, "S0", m.State().GetName())
+		assert.Zero(t, m.Steps())
+	})
+}
+
+func TestMachine_Snapshot(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newModulo3(t)
+		m := fa.NewMachine()
+		assert.NoError(t, m.Step("1", "0"))
+
+		assert.Equal(t, automaton.Snapshot{
+			State:       "S2",
+			Steps:       2,
+			Fingerprint: fa.Fingerprint(),
+		}, m.Snapshot())
+	})
+}
+
+func TestMachine_Restore(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		m := newModulo3(t).NewMachine()
+		assert.NoError(t, m.Step("1", "0"))
+		snapshot := m.Snapshot()
+
+		restored := newModulo3(t).NewMachine()
+		assert.NoError(t, restored.Restore(snapshot))
+		assert.Equal(t, "S2", restored.State().GetName())
+		assert.Equal(t, 2, restored.Steps())
+	})
+
+	t.Run("different definition", func(t *testing.T) {
+		m := newModulo3(t).NewMachine()
+		snapshot := m.Snapshot()
+
+		fa, err := automaton.NewFiniteAutomation([]string{"S0", "S1", "S2"}, "S0", []string{"S0"}, transition.Transitions{
+			{StartState: "S0", Input: "0", ResultState: "S0"},
+			{StartState: "S0", Input: "1", ResultState: "S1"},
+		})
+		require.NoError(t, err)
+		restored := fa.NewMachine()
+
+		err = restored.Restore(snapshot)
+		assert.ErrorIs(t, err, automaton.ErrSnapshotMismatch)
+	})
+
+	t.Run("state not found", func(t *testing.T) {
+		fa := newModulo3(t)
+		m := fa.NewMachine()
+
+		err := m.Restore(automaton.Snapshot{State: "S3", Fingerprint: fa.Fingerprint()})
+		assert.ErrorIs(t, err, automaton.ErrStateNotFound)
+	})
+}
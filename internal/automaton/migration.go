@@ -0,0 +1,236 @@
+package automaton
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrUnmappedState = errors.New("error state has no mapping in the new automaton")
+)
+
+// StateChanges describes how the states of two versions of an automaton differ.
+type StateChanges struct {
+	// Kept contains the states present in both versions.
+	Kept []string
+
+	// Removed contains the states only present in the old version.
+	Removed []string
+
+	// Added contains the states only present in the new version.
+	Added []string
+
+	// Renamed maps removed states to added states that look like renames.
+	// A removed and an added state are paired when they have the same final flag
+	// and the same incoming and outgoing transitions, and no other state matches either of them.
+	Renamed map[string]string
+}
+
+// DiffStates compares the states of two versions of an automaton.
+func DiffStates(from, to *FiniteAutomation) StateChanges {
+	changes := StateChanges{
+		Kept:    make([]string, 0),
+		Removed: make([]string, 0),
+		Added:   make([]string, 0),
+		Renamed: make(map[string]string),
+	}
+
+	for _, state := range from.States {
+		if _, err := to.States.Find(state.name); err == nil {
+			changes.Kept = append(changes.Kept, state.name)
+		} else {
+			changes.Removed = append(changes.Removed, state.name)
+		}
+	}
+
+	for _, state := range to.States {
+		if !slices.Contains(changes.Kept, state.name) {
+			changes.Added = append(changes.Added, state.name)
+		}
+	}
+
+	// Pair removed and added states with a unique matching signature.
+	removedSignatures := make(map[string][]string)
+	for _, name := range changes.Removed {
+		signature := stateSignature(from, name, changes.Kept)
+		removedSignatures[signature] = append(removedSignatures[signature], name)
+	}
+
+	addedSignatures := make(map[string][]string)
+	for _, name := range changes.Added {
+		signature := stateSignature(to, name, changes.Kept)
+		addedSignatures[signature] = append(addedSignatures[signature], name)
+	}
+
+	for signature, removed := range removedSignatures {
+		added := addedSignatures[signature]
+		if len(removed) == 1 && len(added) == 1 {
+			changes.Renamed[removed[0]] = added[0]
+		}
+	}
+
+	return changes
+}
+
+// stateSignature describes a state by its final flag and its transitions, default and range transitions included.
+// States not in kept are anonymised, so a renamed state has the same signature in both versions.
+func stateSignature(fa *FiniteAutomation, name string, kept []string) string {
+	label := func(state string) string {
+		switch {
+		case state == name:
+			return "self"
+		case slices.Contains(kept, state):
+			return fmt.Sprintf("%q", state)
+		default:
+			return "?"
+		}
+	}
+
+	edges := make([]string, 0)
+	final := false
+	for _, t := range fa.Transitions() {
+		if t.StartState == name {
+			edges = append(edges, fmt.Sprintf("out %q %s", t.Input, label(t.ResultState)))
+		}
+		if t.ResultState == name {
+			edges = append(edges, fmt.Sprintf("in %q %s", t.Input, label(t.StartState)))
+		}
+	}
+	for _, t := range fa.RangeTransitions() {
+		if t.StartState == name {
+			edges = append(edges, fmt.Sprintf("range out %s %s", t.Runes, label(t.ResultState)))
+		}
+		if t.ResultState == name {
+			edges = append(edges, fmt.Sprintf("range in %s %s", t.Runes, label(t.StartState)))
+		}
+	}
+	for _, state := range fa.States {
+		if state.name == name {
+			final = state.final
+			if state.fallback != nil {
+				edges = append(edges, fmt.Sprintf("default out %s", label(state.fallback.name)))
+			}
+		}
+		if state.fallback != nil && state.fallback.name == name {
+			edges = append(edges, fmt.Sprintf("default in %s", label(state.name)))
+		}
+	}
+	slices.Sort(edges)
+
+	return fmt.Sprintf("%t %t %s", final, fa.InitialState.name == name, strings.Join(edges, ";"))
+}
+
+// Migration moves machine snapshots from one version of an automaton to another.
+// States present in both versions are kept, other states must be mapped explicitly.
+type Migration struct {
+	// from is the old version of the automaton.
+	from *FiniteAutomation
+
+	// to is the new version of the automaton.
+	to *FiniteAutomation
+
+	// mapping maps old state names to new state names.
+	mapping map[string]string
+}
+
+// NewMigration creates a new migration between two versions of an automaton.
+func NewMigration(from, to *FiniteAutomation) *Migration {
+	return &Migration{
+		from:    from,
+		to:      to,
+		mapping: make(map[string]string),
+	}
+}
+
+// Map maps a state of the old version to a state of the new version.
+func (m *Migration) Map(oldState, newState string) *Migration {
+	m.mapping[oldState] = newState
+	return m
+}
+
+// MapRenames maps all renames detected by DiffStates that were not mapped explicitly.
+func (m *Migration) MapRenames() *Migration {
+	for oldState, newState := range m.Changes().Renamed {
+		if _, ok := m.mapping[oldState]; !ok {
+			m.mapping[oldState] = newState
+		}
+	}
+
+	return m
+}
+
+// Changes compares the states of the two versions.
+func (m *Migration) Changes() StateChanges {
+	return DiffStates(m.from, m.to)
+}
+
+// Validate checks that every state of the old version resolves to a state of the new version.
+// All problems are reported together.
+func (m *Migration) Validate() error {
+	errs := make([]error, 0)
+	for _, state := range m.from.States {
+		_, err := m.resolve(state.name)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Migrate moves the snapshots to the new version.
+// It fails without migrating anything if any snapshot can't be migrated.
+func (m *Migration) Migrate(snapshots ...Snapshot) ([]Snapshot, error) {
+	fromFingerprint := m.from.Fingerprint()
+	toFingerprint := m.to.Fingerprint()
+
+	migrated := make([]Snapshot, len(snapshots))
+	errs := make([]error, 0)
+	for i, snapshot := range snapshots {
+		if snapshot.Fingerprint != fromFingerprint {
+			errs = append(errs, fmt.Errorf("snapshot %d: %w - %s", i, ErrSnapshotMismatch, snapshot.Fingerprint))
+			continue
+		}
+
+		state, err := m.resolve(snapshot.State)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("snapshot %d: %w", i, err))
+			continue
+		}
+
+		migrated[i] = Snapshot{
+			State:       state,
+			Steps:       snapshot.Steps,
+			Fingerprint: toFingerprint,
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("error migrating snapshots: %w", errors.Join(errs...))
+	}
+
+	return migrated, nil
+}
+
+// resolve returns the name of the new state an old state migrates to.
+func (m *Migration) resolve(oldState string) (string, error) {
+	if _, err := m.from.States.Find(oldState); err != nil {
+		return "", fmt.Errorf("%w - %s", err, oldState)
+	}
+
+	newState, ok := m.mapping[oldState]
+	if !ok {
+		newState = oldState
+	}
+
+	if _, err := m.to.States.Find(newState); err != nil {
+		if !ok {
+			return "", fmt.Errorf("%w - %s", ErrUnmappedState, oldState)
+		}
+		return "", fmt.Errorf("%w - %s", err, newState)
+	}
+
+	return newState, nil
+}
@@ -0,0 +1,155 @@
+package automaton_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newOrderFlow builds an order flow automation with the given name for the payment state.
+func newOrderFlow(t *testing.T, paid string, extra ...string) *automaton.FiniteAutomation {
+	t.Helper()
+
+	states := append([]string{"created", paid, "shipped"}, extra...)
+	fa, err := automaton.NewFiniteAutomation(states, "created", []string{"shipped"}, transition.Transitions{
+		{StartState: "created", Input: "pay", ResultState: paid},
+		{StartState: paid, Input: "ship", ResultState: "shipped"},
+	})
+	require.NoError(t, err)
+
+	return fa
+}
+
+func TestDiffStates(t *testing.T) {
+	t.Run("rename detected", func(t *testing.T) {
+		from := newOrderFlow(t, "paid")
+		to := newOrderFlow(t, "payment_received", "cancelled")
+
+		changes := automaton.DiffStates(from, to)
+		assert.Equal(t, []string{"created", "shipped"}, changes.Kept)
+		assert.Equal(t, []string{"paid"}, changes.Removed)
+		assert.Equal(t, []string{"payment_received", "cancelled"}, changes.Added)
+		assert.Equal(t, map[string]string{"paid": "payment_received"}, changes.Renamed)
+	})
+
+	t.Run("default transitions", func(t *testing.T) {
+		from := newOrderFlow(t, "paid", "a", "b")
+		to := newOrderFlow(t, "paid", "c", "d")
+		require.NoError(t, from.States.SetDefault("a", "created"))
+		require.NoError(t, to.States.SetDefault("d", "created"))
+
+		// Only the default transitions tell the added states apart.
+		changes := automaton.DiffStates(from, to)
+		assert.Equal(t, map[string]string{"a": "d", "b": "c"}, changes.Renamed)
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		from := newOrderFlow(t, "paid", "a", "b")
+		to := newOrderFlow(t, "paid", "c", "d")
+		require.NoError(t, from.States.SetRange("a", transition.Range('a', 'z'), "created"))
+		require.NoError(t, to.States.SetRange("d", transition.Range('a', 'z'), "created"))
+
+		changes := automaton.DiffStates(from, to)
+		assert.Equal(t, map[string]string{"a": "d", "b": "c"}, changes.Renamed)
+	})
+
+	t.Run("ambiguous rename", func(t *testing.T) {
+		from := newOrderFlow(t, "paid", "a")
+		to := newOrderFlow(t, "paid", "b", "c")
+
+		changes := automaton.DiffStates(from, to)
+		assert.Equal(t, []string{"a"}, changes.Removed)
+		assert.Empty(t, changes.Renamed)
+	})
+}
+
+func TestMigration_Migrate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		from := newOrderFlow(t, "paid")
+		to := newOrderFlow(t, "payment_received")
+
+		m := from.NewMachine()
+		require.NoError(t, m.Step("pay"))
+		paid := m.Snapshot()
+		created := from.NewMachine().Snapshot()
+
+		migrated, err := automaton.NewMigration(from, to).
+			Map("paid", "payment_received").
+			Migrate(paid, created)
+		assert.NoError(t, err)
+		assert.Equal(t, []automaton.Snapshot{
+			{State: "payment_received", Steps: 1, Fingerprint: to.Fingerprint()},
+			{State: "created", Steps: 0, Fingerprint: to.Fingerprint()},
+		}, migrated)
+
+		restored := to.NewMachine()
+		assert.NoError(t, restored.Restore(migrated[0]))
+		assert.NoError(t, restored.Step("ship"))
+		assert.True(t, restored.IsAccepting())
+	})
+
+	t.Run("detected renames", func(t *testing.T) {
+		from := newOrderFlow(t, "paid")
+		to := newOrderFlow(t, "payment_received")
+
+		m := from.NewMachine()
+		require.NoError(t, m.Step("pay"))
+
+		migrated, err := automaton.NewMigration(from, to).MapRenames().Migrate(m.Snapshot())
+		assert.NoError(t, err)
+		assert.Equal(t, "payment_received", migrated[0].State)
+	})
+
+	t.Run("unmapped state", func(t *testing.T) {
+		from := newOrderFlow(t, "paid")
+		to := newOrderFlow(t, "payment_received")
+
+		m := from.NewMachine()
+		require.NoError(t, m.Step("pay"))
+
+		migrated, err := automaton.NewMigration(from, to).Migrate(from.NewMachine().Snapshot(), m.Snapshot())
+		assert.ErrorIs(t, err, automaton.ErrUnmappedState)
+		assert.Nil(t, migrated)
+	})
+
+	t.Run("mapped to unknown state", func(t *testing.T) {
+		from := newOrderFlow(t, "paid")
+		to := newOrderFlow(t, "payment_received")
+
+		m := from.NewMachine()
+		require.NoError(t, m.Step("pay"))
+
+		_, err := automaton.NewMigration(from, to).Map("paid", "settled").Migrate(m.Snapshot())
+		assert.ErrorIs(t, err, automaton.ErrStateNotFound)
+	})
+
+	t.Run("snapshot of another definition", func(t *testing.T) {
+		from := newOrderFlow(t, "paid")
+		to := newOrderFlow(t, "payment_received")
+
+		_, err := automaton.NewMigration(from, to).Migrate(to.NewMachine().Snapshot())
+		assert.ErrorIs(t, err, automaton.ErrSnapshotMismatch)
+	})
+}
+
+func TestMigration_Validate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		from := newOrderFlow(t, "paid")
+		to := newOrderFlow(t, "payment_received")
+
+		assert.NoError(t, automaton.NewMigration(from, to).Map("paid", "payment_received").Validate())
+	})
+
+	t.Run("unmapped states", func(t *testing.T) {
+		from := newOrderFlow(t, "paid", "refunded")
+		to := newOrderFlow(t, "payment_received")
+
+		err := automaton.NewMigration(from, to).Validate()
+		assert.ErrorIs(t, err, automaton.ErrUnmappedState)
+		assert.ErrorContains(t, err, "paid")
+		assert.ErrorContains(t, err, "refunded")
+	})
+}
@@ -0,0 +1,361 @@
+package automaton
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/amitprajapati027/finite-automation/internal/validation"
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+// Epsilon is the input of transitions taken without consuming an input.
+const Epsilon = ""
+
+// NFA describes a nondeterministic finite automation.
+// A state may have several transitions for the same input, and Epsilon transitions.
+type NFA struct {
+	// names contains the state names, states are identified by their index.
+	names []string
+
+	// final describes which states are final states.
+	final []bool
+
+	// initial contains the initial states.
+	initial stateSet
+
+	// delta maps every state's inputs to the result states.
+	delta []map[string][]int
+
+	// alphabet contains all valid inputs.
+	alphabet []string
+}
+
+// NewNFA creates a new NFA object. Transitions with the Epsilon input are taken without consuming an input.
+func NewNFA(Q []string, initial []string, F []string, Delta transition.Transitions) (*NFA, error) {
+	n := &NFA{
+		names:    slices.Clone(Q),
+		final:    make([]bool, len(Q)),
+		delta:    make([]map[string][]int, len(Q)),
+		alphabet: make([]string, 0),
+	}
+
+	index := make(map[string]int, len(Q))
+	for i, q := range Q {
+		if _, ok := index[q]; !ok {
+			index[q] = i
+		}
+		n.delta[i] = make(map[string][]int)
+	}
+
+	find := func(name string) (int, error) {
+		i, ok := index[name]
+		if !ok {
+			return 0, fmt.Errorf("%w - %s", ErrStateNotFound, name)
+		}
+		return i, nil
+	}
+
+	for _, q := range initial {
+		i, err := find(q)
+		if err != nil {
+			return nil, fmt.Errorf("error setting initial states: %w", err)
+		}
+		n.initial = append(n.initial, i)
+	}
+	n.initial = newStateSet(n.initial)
+
+	for _, f := range F {
+		i, err := find(f)
+		if err != nil {
+			return nil, fmt.Errorf("error setting final states: %w", err)
+		}
+		n.final[i] = true
+	}
+
+	for _, d := range Delta {
+		start, err := find(d.StartState)
+		if err != nil {
+			return nil, fmt.Errorf("error setting transitions: %w", err)
+		}
+
+		result, err := find(d.ResultState)
+		if err != nil {
+			return nil, fmt.Errorf("error setting transitions: %w", err)
+		}
+
+		if !slices.Contains(n.delta[start][d.Input], result) {
+			n.delta[start][d.Input] = append(n.delta[start][d.Input], result)
+		}
+
+		if d.Input != Epsilon && !slices.Contains(n.alphabet, d.Input) {
+			n.alphabet = append(n.alphabet, d.Input)
+		}
+	}
+
+	return n, nil
+}
+
+// NFA converts the automation to an equivalent NFA. Default transitions are expanded over the alphabet.
+func (fa *FiniteAutomation) NFA() *NFA {
+	n := &NFA{
+		names:    make([]string, len(fa.States)),
+		final:    make([]bool, len(fa.States)),
+		delta:    make([]map[string][]int, len(fa.States)),
+		alphabet: fa.alphabet(),
+	}
+
+	index := make(map[*State]int, len(fa.States))
+	for i, state := range fa.States {
+		index[state] = i
+	}
+
+	for i, state := range fa.States {
+		n.names[i] = state.name
+		n.final[i] = state.final
+		n.delta[i] = make(map[string][]int)
+		for _, input := range n.alphabet {
+			if next := fa.next(state, input); next != nil {
+				n.delta[i][input] = []int{index[next]}
+			}
+		}
+	}
+	n.initial = stateSet{index[fa.InitialState]}
+
+	return n
+}
+
+// StateNames returns the names of all states.
+func (n *NFA) StateNames() []string {
+	return slices.Clone(n.names)
+}
+
+// InitialStates returns the names of the initial states.
+func (n *NFA) InitialStates() []string {
+	return n.stateNames(n.initial)
+}
+
+// FinalStates returns the names of the final states.
+func (n *NFA) FinalStates() []string {
+	names := make([]string, 0)
+	for i, final := range n.final {
+		if final {
+			names = append(names, n.names[i])
+		}
+	}
+
+	return names
+}
+
+// Alphabet returns the valid inputs of the NFA.
+func (n *NFA) Alphabet() []string {
+	return slices.Clone(n.alphabet)
+}
+
+// Transitions returns all transitions, ordered by state and input, Epsilon transitions first.
+func (n *NFA) Transitions() transition.Transitions {
+	transitions := make(transition.Transitions, 0)
+	inputs := append([]string{Epsilon}, n.alphabet...)
+	for i := range n.names {
+		for _, input := range inputs {
+			for _, j := range n.delta[i][input] {
+				transitions = append(transitions, transition.Transition{
+					StartState:  n.names[i],
+					Input:       input,
+					ResultState: n.names[j],
+				})
+			}
+		}
+	}
+
+	return transitions
+}
+
+// Accepts returns true if the NFA accepts the inputs.
+// Only inputs outside of the alphabet return an error.
+func (n *NFA) Accepts(Sigma ...string) (bool, error) {
+	err := validation.ValidateInputs(Sigma, n.alphabet)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute nfa: %w", err)
+	}
+
+	current := n.closure(n.initial)
+	for _, s := range Sigma {
+		current = n.step(current, s)
+		if len(current) == 0 {
+			return false, nil
+		}
+	}
+
+	return n.accepting(current), nil
+}
+
+// Determinize converts the NFA to an equivalent FiniteAutomation using the subset construction.
+// Only subsets reachable from the initial states are created, and each state is named after
+// the NFA states it contains, for example "{q0,q1}".
+func (n *NFA) Determinize() *FiniteAutomation {
+	// Without limits and cancellation the construction can't fail.
+	fa, _ := n.DeterminizeContext(context.Background(), Limits{})
+	return fa
+}
+
+// DeterminizeContext is like Determinize, but stops when a limit is hit or the context is done.
+func (n *NFA) DeterminizeContext(ctx context.Context, limits Limits) (*FiniteAutomation, error) {
+	budget := NewBudget(ctx, limits)
+	start := n.closure(n.initial)
+	states := map[string]*State{}
+	fa := &FiniteAutomation{
+		States:           make(States, 0),
+		TransitionInputs: slices.Clone(n.alphabet),
+	}
+
+	add := func(set stateSet) (*State, bool, error) {
+		key := set.key()
+		if state, ok := states[key]; ok {
+			return state, false, nil
+		}
+
+		if err := budget.AddState(); err != nil {
+			return nil, false, fmt.Errorf("error determinizing nfa: %w", err)
+		}
+
+		state := NewState(n.subsetName(set))
+		state.final = n.accepting(set)
+		states[key] = state
+		fa.States = append(fa.States, state)
+		return state, true, nil
+	}
+
+	initial, _, err := add(start)
+	if err != nil {
+		return nil, err
+	}
+	fa.InitialState = initial
+
+	sets := []stateSet{start}
+	for i := 0; i < len(sets); i++ {
+		from := states[sets[i].key()]
+		for _, input := range n.alphabet {
+			next := n.step(sets[i], input)
+			if len(next) == 0 {
+				continue
+			}
+
+			to, created, err := add(next)
+			if err != nil {
+				return nil, err
+			}
+			if created {
+				sets = append(sets, next)
+			}
+
+			if err := budget.AddTransition(); err != nil {
+				return nil, fmt.Errorf("error determinizing nfa: %w", err)
+			}
+			from.delta[input] = to
+		}
+	}
+
+	return fa, nil
+}
+
+// closure returns the set of states reachable from the set with Epsilon transitions.
+func (n *NFA) closure(set stateSet) stateSet {
+	result := slices.Clone(set)
+	seen := make(map[int]bool, len(set))
+	for _, q := range set {
+		seen[q] = true
+	}
+
+	for i := 0; i < len(result); i++ {
+		for _, next := range n.delta[result[i]][Epsilon] {
+			if !seen[next] {
+				seen[next] = true
+				result = append(result, next)
+			}
+		}
+	}
+
+	return newStateSet(result)
+}
+
+// step returns the Epsilon closure of the states reachable from the set with the input.
+func (n *NFA) step(set stateSet, input string) stateSet {
+	next := make(stateSet, 0)
+	for _, q := range set {
+		next = append(next, n.delta[q][input]...)
+	}
+
+	return n.closure(newStateSet(next))
+}
+
+// accepting returns true if the set contains a final state.
+func (n *NFA) accepting(set stateSet) bool {
+	for _, q := range set {
+		if n.final[q] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stateNames returns the names of the states in the set.
+func (n *NFA) stateNames(set stateSet) []string {
+	names := make([]string, len(set))
+	for i, q := range set {
+		names[i] = n.names[q]
+	}
+
+	return names
+}
+
+// subsetName returns the name of the deterministic state for the set.
+func (n *NFA) subsetName(set stateSet) string {
+	return "{" + strings.Join(n.stateNames(set), ",") + "}"
+}
+
+// stateSet is a sorted set of NFA state indexes.
+type stateSet []int
+
+// newStateSet sorts the states and removes duplicates.
+func newStateSet(states []int) stateSet {
+	slices.Sort(states)
+	return slices.Compact(states)
+}
+
+// key returns a string uniquely identifying the set, to be used as a map key.
+func (s stateSet) key() string {
+	var sb strings.Builder
+	for i, q := range s {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.Itoa(q))
+	}
+
+	return sb.String()
+}
+
+// contains returns true if the set contains the state.
+func (s stateSet) contains(q int) bool {
+	_, found := slices.BinarySearch(s, q)
+	return found
+}
+
+// subsetOf returns true if every state of the set is in other.
+func (s stateSet) subsetOf(other stateSet) bool {
+	if len(s) > len(other) {
+		return false
+	}
+
+	for _, q := range s {
+		if !other.contains(q) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,155 @@
+package automaton_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/internal/validation"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEndsWithAB builds an NFA accepting inputs over "a" and "b" ending with "ab".
+func newEndsWithAB(t *testing.T) *automaton.NFA {
+	t.Helper()
+
+	n, err := automaton.NewNFA([]string{"q0", "q1", "q2"}, []string{"q0"}, []string{"q2"}, transition.Transitions{
+		{StartState: "q0", Input: "a", ResultState: "q0"},
+		{StartState: "q0", Input: "b", ResultState: "q0"},
+		{StartState: "q0", Input: "a", ResultState: "q1"},
+		{StartState: "q1", Input: "b", ResultState: "q2"},
+	})
+	require.NoError(t, err)
+
+	return n
+}
+
+func TestNewNFA(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		n := newEndsWithAB(t)
+
+		assert.Equal(t, []string{"q0", "q1", "q2"}, n.StateNames())
+		assert.Equal(t, []string{"q0"}, n.InitialStates())
+		assert.Equal(t, []string{"q2"}, n.FinalStates())
+		assert.Equal(t, []string{"a", "b"}, n.Alphabet())
+		assert.Equal(t, transition.Transitions{
+			{StartState: "q0", Input: "a", ResultState: "q0"},
+			{StartState: "q0", Input: "a", ResultState: "q1"},
+			{StartState: "q0", Input: "b", ResultState: "q0"},
+			{StartState: "q1", Input: "b", ResultState: "q2"},
+		}, n.Transitions())
+	})
+
+	t.Run("state not found", func(t *testing.T) {
+		_, err := automaton.NewNFA([]string{"q0"}, []string{"q1"}, nil, nil)
+		assert.ErrorIs(t, err, automaton.ErrStateNotFound)
+
+		_, err = automaton.NewNFA([]string{"q0"}, []string{"q0"}, []string{"q1"}, nil)
+		assert.ErrorIs(t, err, automaton.ErrStateNotFound)
+
+		_, err = automaton.NewNFA([]string{"q0"}, []string{"q0"}, nil, transition.Transitions{
+			{StartState: "q0", Input: "a", ResultState: "q1"},
+		})
+		assert.ErrorIs(t, err, automaton.ErrStateNotFound)
+	})
+}
+
+func TestNFA_Accepts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		n := newEndsWithAB(t)
+
+		for _, sigma := range [][]string{{"a", "b"}, {"b", "a", "a", "b"}} {
+			accepted, err := n.Accepts(sigma...)
+			assert.NoError(t, err)
+			assert.True(t, accepted, sigma)
+		}
+
+		for _, sigma := range [][]string{{}, {"a"}, {"a", "b", "a"}} {
+			accepted, err := n.Accepts(sigma...)
+			assert.NoError(t, err)
+			assert.False(t, accepted, sigma)
+		}
+	})
+
+	t.Run("epsilon transitions", func(t *testing.T) {
+		n, err := automaton.NewNFA([]string{"q0", "q1", "q2"}, []string{"q0"}, []string{"q2"}, transition.Transitions{
+			{StartState: "q0", Input: automaton.Epsilon, ResultState: "q1"},
+			{StartState: "q1", Input: "a", ResultState: "q1"},
+			{StartState: "q1", Input: automaton.Epsilon, ResultState: "q2"},
+		})
+		require.NoError(t, err)
+
+		accepted, err := n.Accepts()
+		assert.NoError(t, err)
+		assert.True(t, accepted)
+
+		accepted, err = n.Accepts("a", "a")
+		assert.NoError(t, err)
+		assert.True(t, accepted)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := newEndsWithAB(t).Accepts("c")
+		assert.ErrorIs(t, err, validation.ErrInvalidInput)
+	})
+}
+
+func TestNFA_Determinize(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newEndsWithAB(t).Determinize()
+
+		assert.Equal(t, []string{"{q0}", "{q0,q1}", "{q0,q2}"}, fa.StateNames())
+		assert.Equal(t, "{q0}", fa.InitialState.GetName())
+		assert.Equal(t, []string{"{q0,q2}"}, fa.FinalStates())
+
+		for word := range fa.Words(6) {
+			assert.Equal(t, []string{"a", "b"}, word[len(word)-2:])
+		}
+		assert.Equal(t, int64(16), fa.Count(6).Int64())
+	})
+}
+
+func TestNFA_DeterminizeContext(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa, err := newEndsWithAB(t).DeterminizeContext(context.Background(), automaton.Limits{MaxStates: 3})
+		require.NoError(t, err)
+
+		assert.Equal(t, newEndsWithAB(t).Determinize().Fingerprint(), fa.Fingerprint())
+	})
+
+	t.Run("budget exceeded", func(t *testing.T) {
+		// The subset construction has 4096 states.
+		_, err := newNthFromEnd(t, 12).DeterminizeContext(context.Background(), automaton.Limits{MaxStates: 100})
+		assert.ErrorIs(t, err, automaton.ErrBudgetExceeded)
+
+		_, err = newNthFromEnd(t, 12).DeterminizeContext(context.Background(), automaton.Limits{MaxTransitions: 100})
+		assert.ErrorIs(t, err, automaton.ErrBudgetExceeded)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := newEndsWithAB(t).DeterminizeContext(ctx, automaton.Limits{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestFiniteAutomation_NFA(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newModulo3(t)
+		n := fa.NFA()
+
+		assert.Equal(t, fa.StateNames(), n.StateNames())
+		assert.Equal(t, []string{"S0"}, n.InitialStates())
+		assert.Equal(t, fa.Transitions(), n.Transitions())
+
+		for word := range fa.Words(5) {
+			accepted, err := n.Accepts(word...)
+			assert.NoError(t, err)
+			assert.True(t, accepted)
+		}
+	})
+}
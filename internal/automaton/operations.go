@@ -0,0 +1,148 @@
+package automaton
+
+import (
+	"slices"
+)
+
+// Concat returns an NFA accepting the inputs made of an input accepted by a followed by an input accepted by b.
+// The states of a are prefixed with "a." and the states of b with "b.".
+func Concat(a, b *NFA) *NFA {
+	n := &NFA{}
+	offsetA := n.include(a, "a.")
+	offsetB := n.include(b, "b.")
+
+	n.initial = shift(a.initial, offsetA)
+	for q, final := range a.final {
+		if !final {
+			continue
+		}
+
+		n.final[q+offsetA] = false
+		for _, i := range b.initial {
+			n.addTransition(q+offsetA, Epsilon, i+offsetB)
+		}
+	}
+
+	return n
+}
+
+// Star returns an NFA accepting the concatenations of zero or more inputs accepted by a.
+// The states of a are prefixed with "a.", and a new initial and final state named "start" is added.
+func Star(a *NFA) *NFA {
+	return Optional(Plus(a))
+}
+
+// Plus returns an NFA accepting the concatenations of one or more inputs accepted by a.
+// The states keep their names, final states get Epsilon transitions back to the initial states.
+func Plus(a *NFA) *NFA {
+	n := &NFA{}
+	n.include(a, "")
+	n.initial = slices.Clone(a.initial)
+
+	for q, final := range a.final {
+		if !final {
+			continue
+		}
+
+		for _, i := range a.initial {
+			n.addTransition(q, Epsilon, i)
+		}
+	}
+
+	return n
+}
+
+// Optional returns an NFA accepting the empty input and the inputs accepted by a.
+// The states of a are prefixed with "a.", and a new initial and final state named "start" is added.
+func Optional(a *NFA) *NFA {
+	n := &NFA{}
+	start := n.addState("start", true)
+	offset := n.include(a, "a.")
+
+	n.initial = stateSet{start}
+	for _, i := range a.initial {
+		n.addTransition(start, Epsilon, i+offset)
+	}
+
+	return n
+}
+
+// Reverse returns an NFA accepting the reversed inputs accepted by a.
+// The states keep their names, the initial and final states are swapped and all transitions reversed.
+func Reverse(a *NFA) *NFA {
+	n := &NFA{}
+	for q, name := range a.names {
+		n.addState(name, a.initial.contains(q))
+	}
+	n.alphabet = slices.Clone(a.alphabet)
+
+	initial := make([]int, 0)
+	for q, final := range a.final {
+		if final {
+			initial = append(initial, q)
+		}
+	}
+	n.initial = newStateSet(initial)
+
+	inputs := append([]string{Epsilon}, a.alphabet...)
+	for q := range a.names {
+		for _, input := range inputs {
+			for _, next := range a.delta[q][input] {
+				n.addTransition(next, input, q)
+			}
+		}
+	}
+
+	return n
+}
+
+// include copies the states and transitions of other into the NFA, prefixing their names.
+// It returns the index of the first copied state. Initial states are not copied.
+func (n *NFA) include(other *NFA, prefix string) int {
+	offset := len(n.names)
+	for q, name := range other.names {
+		n.addState(prefix+name, other.final[q])
+	}
+
+	for q := range other.names {
+		for input, targets := range other.delta[q] {
+			for _, next := range targets {
+				n.addTransition(q+offset, input, next+offset)
+			}
+		}
+	}
+
+	for _, input := range other.alphabet {
+		if !slices.Contains(n.alphabet, input) {
+			n.alphabet = append(n.alphabet, input)
+		}
+	}
+
+	return offset
+}
+
+// addState adds a state and returns its index.
+func (n *NFA) addState(name string, final bool) int {
+	n.names = append(n.names, name)
+	n.final = append(n.final, final)
+	n.delta = append(n.delta, make(map[string][]int))
+
+	return len(n.names) - 1
+}
+
+// addTransition adds a transition between two states.
+func (n *NFA) addTransition(from int, input string, to int) {
+	if !slices.Contains(n.delta[from][input], to) {
+		n.delta[from][input] = append(n.delta[from][input], to)
+	}
+}
+
+// shift returns the set with every state moved by offset.
+func shift(set stateSet, offset int) stateSet {
+	shifted := make(stateSet, len(set))
+	for i, q := range set {
+		shifted[i] = q + offset
+	}
+
+	return shifted
+}
@@ -0,0 +1,103 @@
+package automaton_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newWord builds an NFA accepting exactly the symbols of word.
+func newWord(t *testing.T, word ...string) *automaton.NFA {
+	t.Helper()
+
+	states := []string{"w0"}
+	transitions := make(transition.Transitions, 0)
+	for i, symbol := range word {
+		states = append(states, "w"+string(rune('1'+i)))
+		transitions = append(transitions, transition.Transition{StartState: states[i], Input: symbol, ResultState: states[i+1]})
+	}
+
+	n, err := automaton.NewNFA(states, []string{"w0"}, []string{states[len(states)-1]}, transitions)
+	require.NoError(t, err)
+
+	return n
+}
+
+// accepted returns the accepted inputs of at most maxLen symbols, joined as strings.
+func accepted(n *automaton.NFA, maxLen int) []string {
+	words := make([]string, 0)
+	for word := range n.Determinize().Words(maxLen) {
+		words = append(words, strings.Join(word, ""))
+	}
+
+	return words
+}
+
+func TestConcat(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		n := automaton.Concat(newWord(t, "a", "b"), newWord(t, "c"))
+
+		assert.Equal(t, []string{"a.w0", "a.w1", "a.w2", "b.w0", "b.w1"}, n.StateNames())
+		assert.Equal(t, []string{"a", "b", "c"}, n.Alphabet())
+		assert.Equal(t, []string{"abc"}, accepted(n, 5))
+	})
+
+	t.Run("same state names", func(t *testing.T) {
+		n := automaton.Concat(newModulo3(t).NFA(), newModulo3(t).NFA())
+
+		assert.True(t, slices.Contains(n.StateNames(), "a.S0"))
+		assert.True(t, slices.Contains(n.StateNames(), "b.S0"))
+
+		ok, err := n.Accepts("1", "1", "0", "1", "1")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
+
+func TestStar(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		n := automaton.Star(newWord(t, "a", "b"))
+
+		assert.Equal(t, []string{"", "ab", "abab"}, accepted(n, 5))
+		assert.Equal(t, "start", n.StateNames()[0])
+	})
+}
+
+func TestPlus(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		n := automaton.Plus(newWord(t, "a", "b"))
+
+		assert.Equal(t, []string{"ab", "abab"}, accepted(n, 5))
+	})
+}
+
+func TestOptional(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		n := automaton.Optional(newWord(t, "a", "b"))
+
+		assert.Equal(t, []string{"", "ab"}, accepted(n, 5))
+	})
+}
+
+func TestReverse(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		n := automaton.Reverse(automaton.Concat(newWord(t, "a", "b"), automaton.Star(newWord(t, "c"))))
+
+		assert.Equal(t, []string{"ba", "cba", "ccba"}, accepted(n, 4))
+	})
+
+	t.Run("reversed automation", func(t *testing.T) {
+		// As 2 = -1 (mod 3), a binary number is divisible by 3 exactly when its reversal is.
+		reversed := automaton.Reverse(newModulo3(t).NFA()).Determinize()
+
+		ok, witness := automaton.Subset(reversed, newModulo3(t))
+		assert.True(t, ok, witness)
+		ok, witness = automaton.Subset(newModulo3(t), reversed)
+		assert.True(t, ok, witness)
+	})
+}
@@ -0,0 +1,208 @@
+package automaton
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+// statePair is a pair of states explored in lockstep in two automata.
+// A nil state means the automaton has already rejected the input.
+type statePair struct {
+	a *State
+	b *State
+}
+
+// name returns the name of the product state, with "-" for a nil state.
+func (p statePair) name() string {
+	names := [2]string{"-", "-"}
+	if p.a != nil {
+		names[0] = p.a.name
+	}
+	if p.b != nil {
+		names[1] = p.b.name
+	}
+
+	return fmt.Sprintf("(%s,%s)", names[0], names[1])
+}
+
+// Product returns an automation running a and b in lockstep over both alphabets. It accepts an input
+// when accept returns true given whether a and b accept it. Only reachable pairs of states are created,
+// and each state is named after its pair, for example "(S0,S1)", with "-" once an automaton rejected.
+// When accept holds if neither accepts, the pair "(-,-)" is a final sink looping on every input.
+// Range transitions of either automaton become range transitions of the product.
+func Product(a, b *FiniteAutomation, accept func(acceptedByA, acceptedByB bool) bool) *FiniteAutomation {
+	// Without limits and cancellation the construction can't fail.
+	fa, _ := ProductContext(context.Background(), a, b, accept, Limits{})
+	return fa
+}
+
+// ProductContext is like Product, but stops when a limit is hit or the context is done.
+func ProductContext(ctx context.Context, a, b *FiniteAutomation, accept func(acceptedByA, acceptedByB bool) bool, limits Limits) (*FiniteAutomation, error) {
+	budget := NewBudget(ctx, limits)
+	fa := &FiniteAutomation{
+		States:           make(States, 0),
+		TransitionInputs: unionInputs(a, b),
+	}
+
+	// Pairs where one automaton rejected are only useful if the other one can still accept alone.
+	keepWithoutA := accept(false, true) || accept(false, false)
+	keepWithoutB := accept(true, false) || accept(false, false)
+	keepWithoutBoth := accept(false, false)
+
+	states := map[statePair]*State{}
+	pairs := make([]statePair, 0)
+	add := func(pair statePair) (*State, error) {
+		if state, ok := states[pair]; ok {
+			return state, nil
+		}
+
+		if err := budget.AddState(); err != nil {
+			return nil, fmt.Errorf("error building product: %w", err)
+		}
+
+		state := NewState(pair.name())
+		state.final = accept(accepts(pair.a), accepts(pair.b))
+		states[pair] = state
+		pairs = append(pairs, pair)
+		fa.States = append(fa.States, state)
+		return state, nil
+	}
+
+	initial, err := add(statePair{a: a.InitialState, b: b.InitialState})
+	if err != nil {
+		return nil, err
+	}
+	fa.InitialState = initial
+
+	syms := symbols(a, b)
+	validA, validB := a.valid(syms), b.valid(syms)
+	for i := 0; i < len(pairs); i++ {
+		pair := pairs[i]
+		ranges := make(map[*State]transition.RuneSet)
+		targets := make(States, 0)
+		for j, sym := range syms {
+			next := statePair{a: pair.a.move(sym.input, validA[j]), b: pair.b.move(sym.input, validB[j])}
+			if next.a == nil && next.b == nil {
+				// Once both rejected, the pair is a sink, only kept if it accepts.
+				if !keepWithoutBoth {
+					continue
+				}
+			} else if (next.a == nil && !keepWithoutA) || (next.b == nil && !keepWithoutB) {
+				continue
+			}
+
+			to, err := add(next)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := budget.AddTransition(); err != nil {
+				return nil, fmt.Errorf("error building product: %w", err)
+			}
+			if sym.class == nil {
+				states[pair].delta[sym.input] = to
+				continue
+			}
+
+			if _, ok := ranges[to]; !ok {
+				targets = append(targets, to)
+			}
+			ranges[to] = append(ranges[to], sym.class...)
+		}
+
+		for _, to := range targets {
+			states[pair].SetRange(transition.NewRuneSet(ranges[to]...), to)
+		}
+	}
+
+	return fa, nil
+}
+
+// Intersection returns an automation accepting the inputs accepted by both a and b.
+func Intersection(a, b *FiniteAutomation) *FiniteAutomation {
+	return Product(a, b, func(acceptedByA, acceptedByB bool) bool {
+		return acceptedByA && acceptedByB
+	})
+}
+
+// Union returns an automation accepting the inputs accepted by a or b.
+func Union(a, b *FiniteAutomation) *FiniteAutomation {
+	return Product(a, b, func(acceptedByA, acceptedByB bool) bool {
+		return acceptedByA || acceptedByB
+	})
+}
+
+// accepts returns true if the state is a final state.
+func accepts(s *State) bool {
+	return s != nil && s.final
+}
+
+// valid returns, for every symbol, whether it's a valid input of the automation.
+func (fa *FiniteAutomation) valid(syms []symbol) []bool {
+	valid := make([]bool, len(syms))
+	for i, sym := range syms {
+		valid[i] = fa.isInput(sym.input)
+	}
+
+	return valid
+}
+
+// unionInputs returns the inputs of both automata, in order of appearance.
+func unionInputs(a, b *FiniteAutomation) []string {
+	inputs := slices.Clone(a.TransitionInputs)
+	for _, input := range b.TransitionInputs {
+		if !slices.Contains(inputs, input) {
+			inputs = append(inputs, input)
+		}
+	}
+
+	return inputs
+}
+
+// distinguish searches for the shortest input for which differs returns true,
+// given whether a and b accept the input. It returns false if there is none.
+// Inputs rejected by both automata are not explored further.
+func distinguish(a, b *FiniteAutomation, differs func(acceptedByA, acceptedByB bool) bool) ([]string, bool) {
+	syms := symbols(a, b)
+	validA, validB := a.valid(syms), b.valid(syms)
+
+	start := statePair{a: a.InitialState, b: b.InitialState}
+	parents := map[statePair]statePair{start: start}
+	inputs := map[statePair]string{}
+
+	queue := []statePair{start}
+	for len(queue) > 0 {
+		pair := queue[0]
+		queue = queue[1:]
+
+		if differs(accepts(pair.a), accepts(pair.b)) {
+			// Walk back to the start to recover the input.
+			word := make([]string, 0)
+			for pair != start {
+				word = append(word, inputs[pair])
+				pair = parents[pair]
+			}
+			slices.Reverse(word)
+
+			return word, true
+		}
+
+		for i, sym := range syms {
+			next := statePair{a: pair.a.move(sym.input, validA[i]), b: pair.b.move(sym.input, validB[i])}
+			if next.a == nil && next.b == nil {
+				continue
+			}
+
+			if _, ok := parents[next]; !ok {
+				parents[next] = pair
+				inputs[next] = sym.input
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,153 @@
+package automaton_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Multiples of 3 that are not multiples of 6.
+		fa := automaton.Product(newModulo3(t), newModulo6(t), func(acceptedByA, acceptedByB bool) bool {
+			return acceptedByA && !acceptedByB
+		})
+
+		assert.Equal(t, "(S0,R0)", fa.InitialState.GetName())
+		assert.Len(t, fa.States, 6)
+		assert.Equal(t, []string{"0", "1"}, fa.Alphabet())
+
+		shortest, ok := fa.ShortestAccepted()
+		assert.True(t, ok)
+		assert.Equal(t, []string{"1", "1"}, shortest)
+	})
+}
+
+// newOnly builds an automaton over x and y accepting only the input.
+func newOnly(t *testing.T, input string) *automaton.FiniteAutomation {
+	t.Helper()
+
+	fa, err := automaton.NewFiniteAutomation([]string{"s", "f"}, "s", []string{"f"}, transition.Transitions{
+		{StartState: "s", Input: input, ResultState: "f"},
+	})
+	require.NoError(t, err)
+	fa.TransitionInputs = []string{"x", "y"}
+
+	return fa
+}
+
+// accepts returns whether fa accepts each of the inputs, given as strings of symbols.
+func accepts(t *testing.T, fa *automaton.FiniteAutomation, inputs ...string) []bool {
+	t.Helper()
+
+	result := make([]bool, len(inputs))
+	for i, input := range inputs {
+		ok, err := fa.Accepts(strings.Split(input, "")...)
+		require.NoError(t, err)
+		result[i] = ok
+	}
+
+	return result
+}
+
+func TestProduct_NeitherAccepts(t *testing.T) {
+	t.Run("nor", func(t *testing.T) {
+		fa := automaton.Product(newOnly(t, "x"), newOnly(t, "x"), func(acceptedByA, acceptedByB bool) bool {
+			return !acceptedByA && !acceptedByB
+		})
+
+		assert.Contains(t, fa.StateNames(), "(-,-)")
+		assert.Equal(t, []bool{true, false, true, true, true}, accepts(t, fa, "", "x", "y", "xx", "yxy"))
+	})
+
+	t.Run("xnor", func(t *testing.T) {
+		fa := automaton.Product(newOnly(t, "x"), newOnly(t, "y"), func(acceptedByA, acceptedByB bool) bool {
+			return acceptedByA == acceptedByB
+		})
+
+		assert.Equal(t, []bool{true, false, false, true, true}, accepts(t, fa, "", "x", "y", "xy", "yy"))
+	})
+}
+
+func TestProductContext(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa, err := automaton.ProductContext(context.Background(), newModulo3(t), newModulo6(t), func(acceptedByA, acceptedByB bool) bool {
+			return acceptedByA && acceptedByB
+		}, automaton.Limits{MaxStates: 6, MaxTransitions: 12})
+		require.NoError(t, err)
+
+		assert.Len(t, fa.States, 6)
+	})
+
+	t.Run("budget exceeded", func(t *testing.T) {
+		_, err := automaton.ProductContext(context.Background(), newModulo3(t), newModulo6(t), func(acceptedByA, acceptedByB bool) bool {
+			return acceptedByA && acceptedByB
+		}, automaton.Limits{MaxStates: 5})
+
+		var exceeded *automaton.BudgetExceededError
+		assert.ErrorAs(t, err, &exceeded)
+		assert.Equal(t, automaton.LimitStates, exceeded.Limit)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := automaton.ProductContext(ctx, newModulo3(t), newModulo6(t), func(acceptedByA, acceptedByB bool) bool {
+			return acceptedByA && acceptedByB
+		}, automaton.Limits{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestIntersection(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := automaton.Intersection(newModulo3(t), newModulo6(t))
+
+		ok, _ := automaton.Subset(fa, newModulo6(t))
+		assert.True(t, ok)
+		ok, _ = automaton.Subset(newModulo6(t), fa)
+		assert.True(t, ok)
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		fa := automaton.Intersection(newIdentifier(t), newLowercase(t, "q"))
+
+		assert.Equal(t, big.NewInt(25), fa.Count(1))
+		assert.Equal(t, big.NewInt(25*26), fa.Count(2))
+		assert.Equal(t, big.NewInt(0), fa.Count(3))
+
+		_, err := fa.ExecuteString("ab")
+		assert.NoError(t, err)
+		_, err = fa.ExecuteString("qx")
+		assert.Error(t, err)
+	})
+
+	t.Run("partial", func(t *testing.T) {
+		fa := automaton.Intersection(newPartial(t), newEndsWithAB(t).Determinize())
+
+		// Pairs where one automaton rejected can't accept, so they're left out.
+		assert.Equal(t, []string{"(s,{q0})", "(a,{q0,q1})", "(f,{q0,q2})"}, fa.StateNames())
+		assert.Equal(t, []string{"(f,{q0,q2})"}, fa.FinalStates())
+	})
+}
+
+func TestUnion(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := automaton.Union(newPartial(t), newWord(t, "b", "a").Determinize())
+
+		assert.Equal(t, []string{"(s,{w0})", "(a,-)", "(-,{w1})", "(f,-)", "(-,{w2})"}, fa.StateNames())
+
+		words := make([][]string, 0)
+		for word := range fa.Words(3) {
+			words = append(words, word)
+		}
+		assert.Equal(t, [][]string{{"a", "b"}, {"b", "a"}}, words)
+	})
+}
@@ -0,0 +1,182 @@
+package automaton
+
+import (
+	"slices"
+)
+
+// ReachableStates returns the states reachable from the initial state.
+func (fa *FiniteAutomation) ReachableStates() States {
+	reachable := fa.reachable()
+	return fa.filter(func(s *State) bool { return reachable[s] })
+}
+
+// ProductiveStates returns the states from which a final state can be reached.
+func (fa *FiniteAutomation) ProductiveStates() States {
+	productive := fa.productive()
+	return fa.filter(func(s *State) bool { return productive[s] })
+}
+
+// DeadStates returns the states from which no final state can be reached,
+// including sink states that only transition to themselves.
+func (fa *FiniteAutomation) DeadStates() States {
+	productive := fa.productive()
+	return fa.filter(func(s *State) bool { return !productive[s] })
+}
+
+// UselessStates returns the states that are unreachable or dead.
+func (fa *FiniteAutomation) UselessStates() States {
+	useful := fa.useful()
+	return fa.filter(func(s *State) bool { return !useful[s] })
+}
+
+// Trim returns a new automation without useless states and their transitions.
+// The initial state is always kept, so an automation accepting nothing trims to its initial state.
+func (fa *FiniteAutomation) Trim() *FiniteAutomation {
+	useful := fa.useful()
+	useful[fa.InitialState] = true
+
+	return fa.restrict(useful)
+}
+
+// reachable returns the set of states reachable from the initial state.
+func (fa *FiniteAutomation) reachable() map[*State]bool {
+	reachable := map[*State]bool{fa.InitialState: true}
+	queue := States{fa.InitialState}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		for _, next := range fa.successors(state) {
+			if !reachable[next] {
+				reachable[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return reachable
+}
+
+// productive returns the set of states from which a final state can be reached.
+func (fa *FiniteAutomation) productive() map[*State]bool {
+	predecessors := make(map[*State]States)
+	for _, state := range fa.States {
+		for _, next := range fa.successors(state) {
+			predecessors[next] = append(predecessors[next], state)
+		}
+	}
+
+	productive := make(map[*State]bool)
+	queue := make(States, 0)
+	for _, state := range fa.States {
+		if state.final {
+			productive[state] = true
+			queue = append(queue, state)
+		}
+	}
+
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		for _, previous := range predecessors[state] {
+			if !productive[previous] {
+				productive[previous] = true
+				queue = append(queue, previous)
+			}
+		}
+	}
+
+	return productive
+}
+
+// useful returns the set of states that are both reachable and productive.
+func (fa *FiniteAutomation) useful() map[*State]bool {
+	reachable := fa.reachable()
+	productive := fa.productive()
+
+	useful := make(map[*State]bool)
+	for _, state := range fa.States {
+		if reachable[state] && productive[state] {
+			useful[state] = true
+		}
+	}
+
+	return useful
+}
+
+// successors returns the distinct states the state has a transition to, ordered by input.
+// Range transitions come next, and the default transition comes last, if it applies to any input.
+func (fa *FiniteAutomation) successors(s *State) States {
+	successors := make(States, 0, len(s.delta)+1)
+	seen := make(map[*State]bool, len(s.delta)+1)
+	for _, input := range s.inputs(fa.TransitionInputs) {
+		next := s.delta[input]
+		if !seen[next] {
+			seen[next] = true
+			successors = append(successors, next)
+		}
+	}
+
+	for _, edge := range s.ranges {
+		if !seen[edge.target] {
+			seen[edge.target] = true
+			successors = append(successors, edge.target)
+		}
+	}
+
+	if s.fallback != nil && !seen[s.fallback] && len(s.missing(fa.TransitionInputs)) > 0 {
+		successors = append(successors, s.fallback)
+	}
+
+	return successors
+}
+
+// filter returns the states for which keep returns true, in declaration order.
+func (fa *FiniteAutomation) filter(keep func(*State) bool) States {
+	states := make(States, 0)
+	for _, state := range fa.States {
+		if keep(state) {
+			states = append(states, state)
+		}
+	}
+
+	return states
+}
+
+// restrict returns a copy of the automation containing only the kept states and the transitions between them.
+func (fa *FiniteAutomation) restrict(keep map[*State]bool) *FiniteAutomation {
+	copies := make(map[*State]*State)
+	states := make(States, 0, len(keep))
+	for _, state := range fa.States {
+		if keep[state] {
+			copies[state] = NewState(state.name)
+			copies[state].final = state.final
+			states = append(states, copies[state])
+		}
+	}
+
+	for original, copied := range copies {
+		for input, next := range original.delta {
+			if keep[next] {
+				copied.delta[input] = copies[next]
+			}
+		}
+
+		if keep[original.fallback] {
+			copied.fallback = copies[original.fallback]
+		}
+
+		for _, edge := range original.ranges {
+			if keep[edge.target] {
+				copied.ranges = append(copied.ranges, rangeEdge{RuneRange: edge.RuneRange, target: copies[edge.target]})
+			}
+		}
+	}
+
+	return &FiniteAutomation{
+		States:           states,
+		TransitionInputs: slices.Clone(fa.TransitionInputs),
+		InitialState:     copies[fa.InitialState],
+	}
+}
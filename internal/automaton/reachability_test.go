@@ -0,0 +1,104 @@
+package automaton_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// names returns the names of the states.
+func names(states automaton.States) []string {
+	result := make([]string, len(states))
+	for i, state := range states {
+		result[i] = state.GetName()
+	}
+
+	return result
+}
+
+// newWithUselessStates builds an automation with an unreachable state "u" and a sink state "d".
+func newWithUselessStates(t *testing.T) *automaton.FiniteAutomation {
+	t.Helper()
+
+	fa, err := automaton.NewFiniteAutomation([]string{"s", "a", "f", "d", "u"}, "s", []string{"f"}, transition.Transitions{
+		{StartState: "s", Input: "a", ResultState: "a"},
+		{StartState: "s", Input: "b", ResultState: "d"},
+		{StartState: "a", Input: "b", ResultState: "f"},
+		{StartState: "d", Input: "a", ResultState: "d"},
+		{StartState: "d", Input: "b", ResultState: "d"},
+		{StartState: "u", Input: "a", ResultState: "f"},
+	})
+	require.NoError(t, err)
+
+	return fa
+}
+
+func TestFiniteAutomation_ReachableStates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newWithUselessStates(t)
+
+		assert.Equal(t, []string{"s", "a", "f", "d"}, names(fa.ReachableStates()))
+	})
+}
+
+func TestFiniteAutomation_ProductiveStates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newWithUselessStates(t)
+
+		assert.Equal(t, []string{"s", "a", "f", "u"}, names(fa.ProductiveStates()))
+	})
+}
+
+func TestFiniteAutomation_DeadStates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newWithUselessStates(t)
+
+		assert.Equal(t, []string{"d"}, names(fa.DeadStates()))
+	})
+}
+
+func TestFiniteAutomation_UselessStates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newWithUselessStates(t)
+
+		assert.Equal(t, []string{"d", "u"}, names(fa.UselessStates()))
+	})
+
+	t.Run("no useless states", func(t *testing.T) {
+		assert.Empty(t, newModulo3(t).UselessStates())
+	})
+}
+
+func TestFiniteAutomation_Trim(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newWithUselessStates(t)
+		trimmed := fa.Trim()
+
+		assert.Equal(t, []string{"s", "a", "f"}, names(trimmed.States))
+		assert.Equal(t, "s", trimmed.InitialState.GetName())
+		assert.Empty(t, trimmed.UselessStates())
+
+		result, err := trimmed.Execute("a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, "f", result)
+
+		_, err = trimmed.Execute("b")
+		assert.ErrorIs(t, err, automaton.ErrStateTransitionNotFound)
+
+		// The original automation is left untouched.
+		assert.Len(t, fa.States, 5)
+	})
+
+	t.Run("empty language", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s", "f"}, "s", []string{"f"}, transition.Transitions{
+			{StartState: "s", Input: "a", ResultState: "s"},
+		})
+		require.NoError(t, err)
+
+		trimmed := fa.Trim()
+		assert.Equal(t, []string{"s"}, names(trimmed.States))
+	})
+}
@@ -0,0 +1,149 @@
+package automaton
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand/v2"
+
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+var (
+	ErrNoAcceptedInput = errors.New("error automation accepts no input of the requested length")
+)
+
+// WeightFunc returns the relative weight of a transition for random walks.
+// Transitions with a weight of zero or less are never taken.
+type WeightFunc func(t transition.Transition) float64
+
+// Sample draws an accepted input of the given length uniformly at random among all accepted inputs of that length.
+func (fa *FiniteAutomation) Sample(rng *rand.Rand, length int) ([]string, error) {
+	if length < 0 {
+		return nil, fmt.Errorf("%w - %d", ErrNoAcceptedInput, length)
+	}
+
+	syms := symbols(fa)
+	counts := fa.countFrom(length)
+	if counts[length][fa.InitialState].Sign() == 0 {
+		return nil, fmt.Errorf("%w - %d", ErrNoAcceptedInput, length)
+	}
+
+	word := make([]string, 0, length)
+	state := fa.InitialState
+	for k := length; k > 0; k-- {
+		// Pick the next input with a probability proportional to the number of accepted completions.
+		r := randomBelow(rng, counts[k][state])
+		for _, sym := range syms {
+			next := state.move(sym.input, true)
+			if next == nil {
+				continue
+			}
+
+			if count := sym.times(counts[k-1][next]); r.Cmp(count) < 0 {
+				// Every rune of a class has the same number of completions.
+				i := new(big.Int).Div(r, counts[k-1][next])
+				word = append(word, sym.at(int(i.Int64())))
+				state = next
+				break
+			}
+			r.Sub(r, sym.times(counts[k-1][next]))
+		}
+	}
+
+	return word, nil
+}
+
+// RandomWalk draws an accepted input of the given length by walking the transitions from the initial state,
+// choosing each transition with a probability proportional to its weight. Only transitions from which
+// a final state can still be reached in the remaining steps are considered. A nil weight gives every
+// transition the same weight. Default and range transitions are weighted per input, and the runes a range
+// transition shares with no other transition all get the weight of the first of them.
+func (fa *FiniteAutomation) RandomWalk(rng *rand.Rand, length int, weight WeightFunc) ([]string, error) {
+	if length < 0 {
+		return nil, fmt.Errorf("%w - %d", ErrNoAcceptedInput, length)
+	}
+
+	if weight == nil {
+		weight = func(transition.Transition) float64 { return 1 }
+	}
+
+	syms := symbols(fa)
+	viable := fa.viable(length)
+	if !viable[length][fa.InitialState] {
+		return nil, fmt.Errorf("%w - %d", ErrNoAcceptedInput, length)
+	}
+
+	word := make([]string, 0, length)
+	state := fa.InitialState
+	for k := length; k > 0; k-- {
+		choices := make([]symbol, 0, len(syms))
+		weights := make([]float64, 0, len(syms))
+		total := 0.0
+		for _, sym := range syms {
+			next := state.move(sym.input, true)
+			if next == nil || !viable[k-1][next] {
+				continue
+			}
+
+			w := weight(transition.Transition{StartState: state.name, Input: sym.input, ResultState: next.name})
+			if w <= 0 {
+				continue
+			}
+			w *= float64(sym.size())
+
+			choices = append(choices, sym)
+			weights = append(weights, w)
+			total += w
+		}
+
+		if len(choices) == 0 {
+			return nil, fmt.Errorf("%w - %d: no weighted transition from state %s", ErrNoAcceptedInput, length, state.name)
+		}
+
+		choice := len(choices) - 1
+		r := rng.Float64() * total
+		for i, w := range weights {
+			if r < w {
+				choice = i
+				break
+			}
+			r -= w
+		}
+
+		sym := choices[choice]
+		input := sym.input
+		if sym.size() > 1 {
+			input = sym.at(rng.IntN(sym.size()))
+		}
+
+		word = append(word, input)
+		state = state.move(sym.input, true)
+	}
+
+	return word, nil
+}
+
+// randomBelow returns a uniformly distributed random number in [0, n), n must be positive.
+func randomBelow(rng *rand.Rand, n *big.Int) *big.Int {
+	bits := n.BitLen()
+	words := (bits + 63) / 64
+	buf := make([]byte, words*8)
+
+	r := new(big.Int)
+	for {
+		for i := 0; i < words; i++ {
+			v := rng.Uint64()
+			for j := 0; j < 8; j++ {
+				buf[i*8+j] = byte(v >> (8 * j))
+			}
+		}
+
+		// Drop the excess bits, so at least half of the draws are below n.
+		r.SetBytes(buf)
+		r.Rsh(r, uint(words*64-bits))
+		if r.Cmp(n) < 0 {
+			return r
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package automaton_test
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFiniteAutomation_Sample(t *testing.T) {
+	t.Run("uniform", func(t *testing.T) {
+		fa := newModulo3(t)
+		rng := rand.New(rand.NewPCG(1, 2))
+
+		counts := make(map[string]int)
+		for i := 0; i < 6000; i++ {
+			word, err := fa.Sample(rng, 4)
+			require.NoError(t, err)
+
+			accepted, err := fa.Accepts(word...)
+			require.NoError(t, err)
+			require.True(t, accepted)
+
+			counts[strings.Join(word, "")]++
+		}
+
+		// There are 6 accepted inputs of length 4.
+		assert.Len(t, counts, 6)
+		for word, count := range counts {
+			assert.InDelta(t, 1000, count, 150, word)
+		}
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		fa := newLowercase(t, "q")
+		rng := rand.New(rand.NewPCG(1, 2))
+
+		counts := make(map[string]int)
+		for i := 0; i < 2500; i++ {
+			word, err := fa.Sample(rng, 1)
+			require.NoError(t, err)
+
+			accepted, err := fa.Accepts(word...)
+			require.NoError(t, err)
+			require.True(t, accepted, word)
+
+			counts[strings.Join(word, "")]++
+		}
+
+		// There are 25 accepted inputs of length 1.
+		assert.Len(t, counts, 25)
+		for word, count := range counts {
+			assert.InDelta(t, 100, count, 50, word)
+		}
+	})
+
+	t.Run("long inputs", func(t *testing.T) {
+		fa := newModulo3(t)
+		rng := rand.New(rand.NewPCG(3, 4))
+
+		word, err := fa.Sample(rng, 200)
+		assert.NoError(t, err)
+		assert.Len(t, word, 200)
+
+		accepted, err := fa.Accepts(word...)
+		assert.NoError(t, err)
+		assert.True(t, accepted)
+	})
+
+	t.Run("no accepted input", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+
+		word, err := newPartial(t).Sample(rng, 3)
+		assert.ErrorIs(t, err, automaton.ErrNoAcceptedInput)
+		assert.Nil(t, word)
+
+		_, err = newPartial(t).Sample(rng, -1)
+		assert.ErrorIs(t, err, automaton.ErrNoAcceptedInput)
+	})
+}
+
+func TestFiniteAutomation_RandomWalk(t *testing.T) {
+	t.Run("uniform weights", func(t *testing.T) {
+		fa := newModulo3(t)
+		rng := rand.New(rand.NewPCG(1, 2))
+
+		for i := 0; i < 100; i++ {
+			word, err := fa.RandomWalk(rng, 5, nil)
+			require.NoError(t, err)
+			assert.Len(t, word, 5)
+
+			accepted, err := fa.Accepts(word...)
+			require.NoError(t, err)
+			assert.True(t, accepted)
+		}
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		fa := newLowercase(t, "")
+		rng := rand.New(rand.NewPCG(1, 2))
+
+		seen := make(map[string]bool)
+		for i := 0; i < 200; i++ {
+			word, err := fa.RandomWalk(rng, 2, nil)
+			require.NoError(t, err)
+
+			accepted, err := fa.Accepts(word...)
+			require.NoError(t, err)
+			require.True(t, accepted, word)
+
+			seen[word[0]] = true
+		}
+
+		assert.Greater(t, len(seen), 1)
+	})
+
+	t.Run("weighted transitions", func(t *testing.T) {
+		fa := newModulo3(t)
+		rng := rand.New(rand.NewPCG(1, 2))
+
+		onlyZeros := func(t transition.Transition) float64 {
+			if t.Input == "1" {
+				return 0
+			}
+			return 1
+		}
+
+		word, err := fa.RandomWalk(rng, 3, onlyZeros)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"0", "0", "0"}, word)
+	})
+
+	t.Run("no weighted transition", func(t *testing.T) {
+		fa := newModulo3(t)
+		rng := rand.New(rand.NewPCG(1, 2))
+
+		none := func(transition.Transition) float64 { return 0 }
+
+		_, err := fa.RandomWalk(rng, 3, none)
+		assert.ErrorIs(t, err, automaton.ErrNoAcceptedInput)
+	})
+
+	t.Run("no accepted input", func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+
+		_, err := newPartial(t).RandomWalk(rng, 3, nil)
+		assert.ErrorIs(t, err, automaton.ErrNoAcceptedInput)
+	})
+}
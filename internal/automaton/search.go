@@ -0,0 +1,221 @@
+package automaton
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"slices"
+)
+
+// MatchKind selects which match a search reports among those starting at the leftmost position.
+//
+// There is no leftmost-first kind: it prefers the earliest alternative of a pattern, but an automation
+// only describes the accepted inputs, so a|ab and ab|a give the same automation and the same matches.
+type MatchKind int
+
+const (
+	// LeftmostLongest reports the longest match starting at the leftmost position.
+	LeftmostLongest MatchKind = iota
+
+	// LeftmostShortest reports the shortest match starting at the leftmost position, ending at the
+	// first final state reached, without reading further inputs.
+	LeftmostShortest
+)
+
+// SearchOptions configures a search.
+type SearchOptions struct {
+	// Kind selects the reported match, LeftmostLongest by default.
+	Kind MatchKind
+
+	// Overlapping reports the match at every start position, so matches may overlap.
+	// Otherwise the search resumes after each match.
+	Overlapping bool
+
+	// Split splits a reader into inputs, bufio.ScanRunes by default.
+	// It's ignored when searching an input slice.
+	Split bufio.SplitFunc
+}
+
+// Match is a span of inputs accepted by the automation.
+type Match struct {
+	// Start is the index of the first input.
+	Start int
+
+	// End is the index after the last input.
+	End int
+
+	// Inputs are the matched inputs.
+	Inputs []string
+}
+
+// Searcher finds the spans of an input stream accepted by an automation.
+type Searcher struct {
+	// fa is the automation to match.
+	fa *FiniteAutomation
+
+	// productive contains the states from which a final state can be reached.
+	// A match attempt ends at any other state, such as the sink of a complete automation.
+	productive map[*State]bool
+
+	// options configures the search.
+	options SearchOptions
+
+	// read returns the next input of the stream, and io.EOF at its end.
+	read func() (string, error)
+
+	// buffer contains the inputs read but not consumed yet.
+	buffer []string
+
+	// offset is the index of the first input of the buffer.
+	offset int
+
+	// err is the error that ended the stream, nil until then.
+	err error
+
+	// lastEnd is the end of the previous match, -1 before the first one.
+	lastEnd int
+}
+
+// FindAll returns the spans of the inputs accepted by the automation, from left to right.
+// Inputs without a transition or leading to a dead state end a match attempt, inputs made of
+// a single rune also take range transitions.
+// Empty matches are reported when the initial state is final, except right after another match.
+func (fa *FiniteAutomation) FindAll(Sigma []string, options SearchOptions) []Match {
+	i := 0
+	searcher := fa.search(func() (string, error) {
+		if i == len(Sigma) {
+			return "", io.EOF
+		}
+		i++
+		return Sigma[i-1], nil
+	}, options)
+
+	matches := make([]Match, 0)
+	for {
+		// Reading a slice can't fail, the only error is io.EOF.
+		match, err := searcher.Next()
+		if err != nil {
+			return matches
+		}
+		matches = append(matches, match)
+	}
+}
+
+// Search returns a searcher finding the spans of r accepted by the automation.
+// The reader is split into inputs with options.Split, and inputs made of a single rune
+// also take range transitions. Only the inputs of the current match attempt are buffered.
+func (fa *FiniteAutomation) Search(r io.Reader, options SearchOptions) *Searcher {
+	scanner := bufio.NewScanner(r)
+	if options.Split != nil {
+		scanner.Split(options.Split)
+	} else {
+		scanner.Split(bufio.ScanRunes)
+	}
+
+	return fa.search(func() (string, error) {
+		if scanner.Scan() {
+			return scanner.Text(), nil
+		}
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}, options)
+}
+
+// search returns a searcher reading inputs with read.
+func (fa *FiniteAutomation) search(read func() (string, error), options SearchOptions) *Searcher {
+	return &Searcher{
+		fa:         fa,
+		productive: fa.productive(),
+		options:    options,
+		read:       read,
+		buffer:     make([]string, 0),
+		lastEnd:    -1,
+	}
+}
+
+// Next returns the next match. It returns io.EOF at the end of the stream and errors of the stream.
+func (s *Searcher) Next() (Match, error) {
+	for {
+		start := s.offset
+		end := s.match()
+		if s.err != nil && !errors.Is(s.err, io.EOF) {
+			return Match{}, s.err
+		}
+
+		if end >= 0 {
+			match := Match{Start: start, End: end, Inputs: slices.Clone(s.buffer[:end-start])}
+			s.lastEnd = end
+			switch {
+			case end == start && len(s.buffer) == 0:
+				// An empty match at the end of the stream, the next call returns io.EOF.
+			case end == start || s.options.Overlapping:
+				s.consume(1)
+			default:
+				s.consume(end - start)
+			}
+			return match, nil
+		}
+
+		if _, ok := s.peek(0); !ok {
+			return Match{}, s.err
+		}
+		s.consume(1)
+	}
+}
+
+// match returns the end of the match starting at the first input of the buffer, or -1 if there is none.
+func (s *Searcher) match() int {
+	start, end := s.offset, -1
+	state := s.fa.InitialState
+	if state.final && s.lastEnd != start {
+		end = start
+		if s.options.Kind == LeftmostShortest {
+			return end
+		}
+	}
+
+	// Missing transitions lead to nil, which isn't productive either.
+	for i := 0; s.productive[state]; i++ {
+		input, ok := s.peek(i)
+		if !ok {
+			return end
+		}
+
+		state = s.fa.next(state, input)
+		if state != nil && state.final {
+			end = start + i + 1
+			if s.options.Kind == LeftmostShortest {
+				return end
+			}
+		}
+	}
+
+	return end
+}
+
+// peek returns the i-th input of the buffer, reading from the stream if needed.
+// It returns false once the stream ended.
+func (s *Searcher) peek(i int) (string, bool) {
+	for len(s.buffer) <= i {
+		if s.err != nil {
+			return "", false
+		}
+
+		input, err := s.read()
+		if err != nil {
+			s.err = err
+			return "", false
+		}
+		s.buffer = append(s.buffer, input)
+	}
+
+	return s.buffer[i], true
+}
+
+// consume removes n inputs from the buffer.
+func (s *Searcher) consume(n int) {
+	s.buffer = s.buffer[n:]
+	s.offset += n
+}
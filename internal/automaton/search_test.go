@@ -0,0 +1,147 @@
+package automaton_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPattern builds an automaton accepting the inputs a, b, c described by the transitions.
+func newPattern(t *testing.T, Q []string, F []string, Delta transition.Transitions) *automaton.FiniteAutomation {
+	t.Helper()
+
+	fa, err := automaton.NewFiniteAutomation(Q, Q[0], F, Delta)
+	require.NoError(t, err)
+	fa.TransitionInputs = []string{"a", "b", "c"}
+
+	return fa
+}
+
+// newABPlus builds an automaton accepting an a followed by one or more b.
+func newABPlus(t *testing.T) *automaton.FiniteAutomation {
+	return newPattern(t, []string{"S0", "S1", "S2"}, []string{"S2"}, transition.Transitions{
+		{StartState: "S0", Input: "a", ResultState: "S1"},
+		{StartState: "S1", Input: "b", ResultState: "S2"},
+		{StartState: "S2", Input: "b", ResultState: "S2"},
+	})
+}
+
+// spans returns the start and end of the matches.
+func spans(matches []automaton.Match) [][2]int {
+	result := make([][2]int, len(matches))
+	for i, m := range matches {
+		result[i] = [2]int{m.Start, m.End}
+	}
+
+	return result
+}
+
+func TestFiniteAutomation_FindAll(t *testing.T) {
+	inputs := strings.Split("abbcaba", "")
+
+	t.Run("leftmost longest", func(t *testing.T) {
+		matches := newABPlus(t).FindAll(inputs, automaton.SearchOptions{})
+
+		assert.Equal(t, [][2]int{{0, 3}, {4, 6}}, spans(matches))
+		assert.Equal(t, []string{"a", "b", "b"}, matches[0].Inputs)
+	})
+
+	t.Run("leftmost shortest", func(t *testing.T) {
+		matches := newABPlus(t).FindAll(inputs, automaton.SearchOptions{Kind: automaton.LeftmostShortest})
+
+		assert.Equal(t, [][2]int{{0, 2}, {4, 6}}, spans(matches))
+	})
+
+	t.Run("overlapping", func(t *testing.T) {
+		fa := newPattern(t, []string{"S0", "S1", "S2"}, []string{"S2"}, transition.Transitions{
+			{StartState: "S0", Input: "a", ResultState: "S1"},
+			{StartState: "S1", Input: "a", ResultState: "S2"},
+		})
+		aaaa := strings.Split("aaaa", "")
+
+		assert.Equal(t, [][2]int{{0, 2}, {2, 4}}, spans(fa.FindAll(aaaa, automaton.SearchOptions{})))
+		assert.Equal(t, [][2]int{{0, 2}, {1, 3}, {2, 4}}, spans(fa.FindAll(aaaa, automaton.SearchOptions{Overlapping: true})))
+	})
+
+	t.Run("empty matches", func(t *testing.T) {
+		fa := newPattern(t, []string{"S0"}, []string{"S0"}, transition.Transitions{
+			{StartState: "S0", Input: "b", ResultState: "S0"},
+		})
+
+		assert.Equal(t, [][2]int{{0, 0}, {1, 3}, {4, 4}}, spans(fa.FindAll(strings.Split("abba", ""), automaton.SearchOptions{})))
+		assert.Equal(t, [][2]int{{0, 0}}, spans(fa.FindAll(nil, automaton.SearchOptions{})))
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		fa, err := newABPlus(t).Complete(nil, "sink")
+		require.NoError(t, err)
+
+		assert.Equal(t, [][2]int{{0, 3}, {4, 6}}, spans(fa.FindAll(inputs, automaton.SearchOptions{})))
+	})
+
+	t.Run("complete long stream", func(t *testing.T) {
+		fa, err := newABPlus(t).Complete(nil, "sink")
+		require.NoError(t, err)
+
+		// Every attempt hits the sink after one input, instead of scanning to the end of the stream.
+		stream := strings.Split(strings.Repeat("c", 20000)+"ab", "")
+		start := time.Now()
+		assert.Equal(t, [][2]int{{20000, 20002}}, spans(fa.FindAll(stream, automaton.SearchOptions{})))
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		assert.Empty(t, newABPlus(t).FindAll([]string{"a", "x", "b"}, automaton.SearchOptions{}))
+	})
+}
+
+func TestFiniteAutomation_Search(t *testing.T) {
+	t.Run("runes", func(t *testing.T) {
+		searcher := newIdentifier(t).Search(iotest.OneByteReader(strings.NewReader("12 ab_1 x")), automaton.SearchOptions{})
+
+		matches := make([]automaton.Match, 0)
+		for {
+			match, err := searcher.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			matches = append(matches, match)
+		}
+
+		assert.Equal(t, [][2]int{{3, 7}, {8, 9}}, spans(matches))
+		assert.Equal(t, []string{"a", "b", "_", "1"}, matches[0].Inputs)
+	})
+
+	t.Run("words", func(t *testing.T) {
+		searcher := newABPlus(t).Search(strings.NewReader("a b b\nc a b"), automaton.SearchOptions{Split: bufio.ScanWords})
+
+		match, err := searcher.Next()
+		require.NoError(t, err)
+		assert.Equal(t, automaton.Match{Start: 0, End: 3, Inputs: []string{"a", "b", "b"}}, match)
+
+		match, err = searcher.Next()
+		require.NoError(t, err)
+		assert.Equal(t, automaton.Match{Start: 4, End: 6, Inputs: []string{"a", "b"}}, match)
+
+		_, err = searcher.Next()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("stream error", func(t *testing.T) {
+		failure := errors.New("failure")
+		searcher := newABPlus(t).Search(io.MultiReader(strings.NewReader("cab"), iotest.ErrReader(failure)), automaton.SearchOptions{})
+
+		_, err := searcher.Next()
+		assert.ErrorIs(t, err, failure)
+	})
+}
@@ -2,6 +2,10 @@ package automaton
 
 import (
 	"errors"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/amitprajapati027/finite-automation/transition"
 )
 
 var (
@@ -19,6 +23,20 @@ type State struct {
 
 	// delta contains transition information.
 	delta map[string]*State
+
+	// fallback is the state to transition to for inputs without a delta entry.
+	fallback *State
+
+	// ranges contains the range transitions, sorted and disjoint.
+	ranges []rangeEdge
+}
+
+// rangeEdge is a range transition for a range of runes.
+type rangeEdge struct {
+	transition.RuneRange
+
+	// target is the state to transition to.
+	target *State
 }
 
 // NewState constructs and returns a new state
@@ -44,11 +62,181 @@ func (s *State) IsFinal() bool {
 	return s.final
 }
 
+// SetDefault sets the state to transition to for inputs without a transition.
+func (s *State) SetDefault(state *State) {
+	s.fallback = state
+}
+
+// Default returns the state to transition to for inputs without a transition, or nil if there is none.
+func (s *State) Default() *State {
+	return s.fallback
+}
+
 // Transition uses the delta field and returns the next state.
+// If there is no transition for sigma, the default transition is used.
 func (s *State) Transition(sigma string) (*State, error) {
 	newState := s.delta[sigma]
+	if newState == nil {
+		newState = s.fallback
+	}
 	if newState == nil {
 		return nil, ErrStateTransitionNotFound
 	}
 	return newState, nil
 }
+
+// SetRange sets the state to transition to for the runes of the set.
+// It replaces earlier range transitions for the same runes.
+func (s *State) SetRange(runes transition.RuneSet, state *State) {
+	// Sets not created with NewRuneSet may be unsorted or overlap.
+	runes = transition.NewRuneSet(runes...)
+	edges := make([]rangeEdge, 0, len(s.ranges)+len(runes))
+	for _, edge := range s.ranges {
+		for _, r := range (transition.RuneSet{edge.RuneRange}).Subtract(runes) {
+			edges = append(edges, rangeEdge{RuneRange: r, target: edge.target})
+		}
+	}
+	for _, r := range runes {
+		edges = append(edges, rangeEdge{RuneRange: r, target: state})
+	}
+	slices.SortFunc(edges, func(a, b rangeEdge) int { return int(a.Lo) - int(b.Lo) })
+
+	// Merge adjacent ranges with the same target.
+	merged := make([]rangeEdge, 0, len(edges))
+	for _, edge := range edges {
+		if n := len(merged); n > 0 && merged[n-1].target == edge.target && merged[n-1].Hi+1 == edge.Lo {
+			merged[n-1].Hi = edge.Hi
+			continue
+		}
+		merged = append(merged, edge)
+	}
+	s.ranges = merged
+}
+
+// TransitionRune returns the next state for the rune. The transition for the rune as a single input is
+// used first, then range transitions, then the default transition.
+func (s *State) TransitionRune(r rune) (*State, error) {
+	return s.transitionSymbol(string(r), r)
+}
+
+// TransitionByte returns the next state for the byte. The transition for the byte as a single input is
+// used first, then range transitions containing the byte's value, then the default transition.
+func (s *State) TransitionByte(b byte) (*State, error) {
+	return s.transitionSymbol(string([]byte{b}), rune(b))
+}
+
+// transitionSymbol returns the next state for the input, or for the rune if there is no transition for the input.
+func (s *State) transitionSymbol(input string, r rune) (*State, error) {
+	if next := s.delta[input]; next != nil {
+		return next, nil
+	}
+
+	if next := s.rangeTarget(r); next != nil {
+		return next, nil
+	}
+
+	if s.fallback != nil {
+		return s.fallback, nil
+	}
+
+	return nil, ErrStateTransitionNotFound
+}
+
+// rangeTarget returns the target of the range transition containing the rune, or nil if there is none.
+func (s *State) rangeTarget(r rune) *State {
+	i, found := slices.BinarySearchFunc(s.ranges, r, func(edge rangeEdge, r rune) int {
+		switch {
+		case edge.Hi < r:
+			return -1
+		case edge.Lo > r:
+			return 1
+		default:
+			return 0
+		}
+	})
+	if !found {
+		return nil
+	}
+
+	return s.ranges[i].target
+}
+
+// runes returns the runes the state has a range transition for.
+func (s *State) runes() transition.RuneSet {
+	runes := make(transition.RuneSet, len(s.ranges))
+	for i, edge := range s.ranges {
+		runes[i] = edge.RuneRange
+	}
+
+	return transition.NewRuneSet(runes...)
+}
+
+// move returns the state reached from s on input, or nil if there is none. Inputs made of a single rune
+// also take range transitions, and the default transition only applies to valid inputs.
+func (s *State) move(input string, valid bool) *State {
+	if s == nil {
+		return nil
+	}
+
+	if next := s.delta[input]; next != nil {
+		return next
+	}
+
+	if r, ok := singleRune(input); ok {
+		if next := s.rangeTarget(r); next != nil {
+			return next
+		}
+	}
+
+	if valid {
+		return s.fallback
+	}
+
+	return nil
+}
+
+// singleRune returns the rune of an input made of a single valid rune.
+func singleRune(input string) (rune, bool) {
+	r, size := utf8.DecodeRuneInString(input)
+	if size == 0 || size != len(input) || (r == utf8.RuneError && size == 1) {
+		return 0, false
+	}
+
+	return r, true
+}
+
+// inputs returns the inputs the state has a transition for.
+// Inputs are ordered as in order, with any remaining inputs sorted after them.
+func (s *State) inputs(order []string) []string {
+	inputs := make([]string, 0, len(s.delta))
+	seen := make(map[string]bool, len(s.delta))
+	for _, input := range order {
+		if s.delta[input] != nil && !seen[input] {
+			inputs = append(inputs, input)
+			seen[input] = true
+		}
+	}
+
+	rest := make([]string, 0)
+	for input := range s.delta {
+		if !seen[input] {
+			rest = append(rest, input)
+		}
+	}
+	slices.Sort(rest)
+
+	return append(inputs, rest...)
+}
+
+// missing returns the inputs of the alphabet the state has no transition for, range transitions included.
+// The default transition is not taken into account.
+func (s *State) missing(alphabet []string) []string {
+	missing := make([]string, 0)
+	for _, input := range alphabet {
+		if s.move(input, false) == nil {
+			missing = append(missing, input)
+		}
+	}
+
+	return missing
+}
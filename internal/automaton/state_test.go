@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -66,3 +67,112 @@ func TestState_Transition(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+func TestState_SetDefault(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s1 := automaton.NewState("s1")
+		s2 := automaton.NewState("s2")
+		assert.Nil(t, s1.Default())
+
+		s1.SetDefault(s2)
+		assert.Equal(t, s2, s1.Default())
+	})
+}
+
+func TestState_Transition_Default(t *testing.T) {
+	t.Run("default used for missing transition", func(t *testing.T) {
+		s1 := automaton.NewState("s1")
+		s2 := automaton.NewState("s2")
+		s3 := automaton.NewState("s3")
+		states := automaton.States{s1, s2, s3}
+		assert.NoError(t, states.SetDelta("s1", "1", "s2"))
+		s1.SetDefault(s3)
+
+		result, err := s1.Transition("1")
+		assert.NoError(t, err)
+		assert.Equal(t, s2, result)
+
+		result, err = s1.Transition("0")
+		assert.NoError(t, err)
+		assert.Equal(t, s3, result)
+	})
+}
+
+func TestState_SetRange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s1 := automaton.NewState("s1")
+		s2 := automaton.NewState("s2")
+		s3 := automaton.NewState("s3")
+
+		s1.SetRange(transition.Range('a', 'z'), s2)
+
+		// The newer range replaces the overlapping part of the older one.
+		s1.SetRange(transition.Range('m', 'n'), s3)
+
+		for r, expected := range map[rune]*automaton.State{'a': s2, 'l': s2, 'm': s3, 'n': s3, 'o': s2, 'z': s2} {
+			result, err := s1.TransitionRune(r)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result, string(r))
+		}
+
+		_, err := s1.TransitionRune('A')
+		assert.ErrorIs(t, err, automaton.ErrStateTransitionNotFound)
+	})
+
+	t.Run("unnormalized", func(t *testing.T) {
+		s1 := automaton.NewState("s1")
+		s2 := automaton.NewState("s2")
+
+		s1.SetRange(transition.RuneSet{{Lo: 'x', Hi: 'z'}, {Lo: 'z', Hi: 'a'}, {Lo: 'a', Hi: 'c'}}, s2)
+
+		for _, r := range "abcxyz" {
+			result, err := s1.TransitionRune(r)
+			assert.NoError(t, err)
+			assert.Equal(t, s2, result, string(r))
+		}
+
+		_, err := s1.TransitionRune('m')
+		assert.ErrorIs(t, err, automaton.ErrStateTransitionNotFound)
+	})
+}
+
+func TestState_TransitionRune(t *testing.T) {
+	t.Run("precedence", func(t *testing.T) {
+		s1 := automaton.NewState("s1")
+		s2 := automaton.NewState("s2")
+		s3 := automaton.NewState("s3")
+		s4 := automaton.NewState("s4")
+		states := automaton.States{s1, s2, s3, s4}
+		assert.NoError(t, states.SetDelta("s1", "x", "s2"))
+		s1.SetRange(transition.Range('a', 'z'), s3)
+		s1.SetDefault(s4)
+
+		for r, expected := range map[rune]*automaton.State{'x': s2, 'y': s3, '0': s4} {
+			result, err := s1.TransitionRune(r)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result, string(r))
+		}
+	})
+}
+
+func TestState_TransitionByte(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s1 := automaton.NewState("s1")
+		s2 := automaton.NewState("s2")
+		s3 := automaton.NewState("s3")
+		states := automaton.States{s1, s2, s3}
+		assert.NoError(t, states.SetDelta("s1", "\xff", "s2"))
+		s1.SetRange(transition.Range(0x80, 0xff), s3)
+
+		result, err := s1.TransitionByte(0xff)
+		assert.NoError(t, err)
+		assert.Equal(t, s2, result)
+
+		result, err = s1.TransitionByte(0x80)
+		assert.NoError(t, err)
+		assert.Equal(t, s3, result)
+
+		_, err = s1.TransitionByte('a')
+		assert.ErrorIs(t, err, automaton.ErrStateTransitionNotFound)
+	})
+}
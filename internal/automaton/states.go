@@ -1,5 +1,9 @@
 package automaton
 
+import (
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
 // States is a collection of state.
 type States []*State
 
@@ -39,6 +43,40 @@ func (s States) SetDelta(start, sigma, end string) error {
 	return nil
 }
 
+// SetDefault sets the default transition of the start state.
+func (s States) SetDefault(start, end string) error {
+	startState, err := s.Find(start)
+	if err != nil {
+		return err
+	}
+
+	endState, err := s.Find(end)
+	if err != nil {
+		return err
+	}
+
+	startState.SetDefault(endState)
+
+	return nil
+}
+
+// SetRange sets a range transition of the start state.
+func (s States) SetRange(start string, runes transition.RuneSet, end string) error {
+	startState, err := s.Find(start)
+	if err != nil {
+		return err
+	}
+
+	endState, err := s.Find(end)
+	if err != nil {
+		return err
+	}
+
+	startState.SetRange(runes, endState)
+
+	return nil
+}
+
 // Find finds a state by it's name and returns it.
 func (s States) Find(name string) (*State, error) {
 	for _, state := range s {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,6 +65,59 @@ func TestStates_SetDelta(t *testing.T) {
 	})
 }
 
+func TestStates_SetDefault(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s1 := automaton.NewState("s1")
+		s2 := automaton.NewState("s2")
+		states := automaton.States{s1, s2}
+
+		err := states.SetDefault("s1", "s2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, s2, s1.Default())
+	})
+
+	t.Run("start state not found", func(t *testing.T) {
+		s1 := automaton.NewState("s1")
+		states := automaton.States{s1}
+
+		err := states.SetDefault("s3", "s1")
+
+		assert.ErrorIs(t, err, automaton.ErrStateNotFound)
+	})
+
+	t.Run("end state not found", func(t *testing.T) {
+		s1 := automaton.NewState("s1")
+		states := automaton.States{s1}
+
+		err := states.SetDefault("s1", "s3")
+
+		assert.ErrorIs(t, err, automaton.ErrStateNotFound)
+	})
+}
+
+func TestStates_SetRange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		s1 := automaton.NewState("s1")
+		s2 := automaton.NewState("s2")
+		states := automaton.States{s1, s2}
+
+		err := states.SetRange("s1", transition.Range('0', '9'), "s2")
+		assert.NoError(t, err)
+
+		result, err := s1.TransitionRune('5')
+		assert.NoError(t, err)
+		assert.Equal(t, s2, result)
+	})
+
+	t.Run("state not found", func(t *testing.T) {
+		states := automaton.States{automaton.NewState("s1")}
+
+		assert.ErrorIs(t, states.SetRange("s3", transition.Range('0', '9'), "s1"), automaton.ErrStateNotFound)
+		assert.ErrorIs(t, states.SetRange("s1", transition.Range('0', '9'), "s3"), automaton.ErrStateNotFound)
+	})
+}
+
 func TestStates_Find(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		s1 := automaton.NewState("s1")
@@ -0,0 +1,105 @@
+package automaton
+
+import (
+	"slices"
+)
+
+// Subset decides whether every input accepted by a is accepted by b.
+// If not, it also returns a shortest input accepted by a but rejected by b.
+func Subset(a, b *FiniteAutomation) (bool, []string) {
+	witness, found := distinguish(a, b, func(acceptedByA, acceptedByB bool) bool {
+		return acceptedByA && !acceptedByB
+	})
+
+	return !found, witness
+}
+
+// SubsetNFA decides whether every input accepted by a is accepted by b.
+// If not, it also returns an input accepted by a but rejected by b.
+//
+// It explores pairs of a state of a and the set of states b can be in, without determinizing b.
+// A pair is pruned when another pair for the same state of a has a subset of its states of b,
+// as any input rejected from the pruned pair is also rejected from the smaller one (antichain).
+func SubsetNFA(a, b *NFA) (bool, []string) {
+	inputs := slices.Clone(a.alphabet)
+	for _, input := range b.alphabet {
+		if !slices.Contains(inputs, input) {
+			inputs = append(inputs, input)
+		}
+	}
+
+	type node struct {
+		state  int
+		states stateSet
+		parent *node
+		input  string
+
+		// pruned is true once a pair with a subset of the states was found.
+		pruned bool
+	}
+
+	// antichain contains, per state of a, the minimal sets of states of b found so far.
+	antichain := make(map[int][]*node)
+	covered := func(n *node) bool {
+		for _, other := range antichain[n.state] {
+			if other.states.subsetOf(n.states) {
+				return true
+			}
+		}
+		return false
+	}
+	add := func(n *node) {
+		kept := make([]*node, 0, len(antichain[n.state]))
+		for _, other := range antichain[n.state] {
+			if n.states.subsetOf(other.states) {
+				other.pruned = true
+			} else {
+				kept = append(kept, other)
+			}
+		}
+		antichain[n.state] = append(kept, n)
+	}
+
+	queue := make([]*node, 0)
+	start := b.closure(b.initial)
+	for _, q := range a.closure(a.initial) {
+		n := &node{state: q, states: start}
+		if !covered(n) {
+			add(n)
+			queue = append(queue, n)
+		}
+	}
+
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		if n.pruned {
+			continue
+		}
+
+		if a.final[n.state] && !b.accepting(n.states) {
+			// Walk back to the start to recover the input.
+			word := make([]string, 0)
+			for ; n.parent != nil; n = n.parent {
+				word = append(word, n.input)
+			}
+			slices.Reverse(word)
+
+			return false, word
+		}
+
+		for _, input := range inputs {
+			states := b.step(n.states, input)
+			for _, q := range a.step(stateSet{n.state}, input) {
+				next := &node{state: q, states: states, parent: n, input: input}
+				if !covered(next) {
+					add(next)
+					queue = append(queue, next)
+				}
+			}
+		}
+	}
+
+	return true, nil
+}
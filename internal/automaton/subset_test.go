@@ -0,0 +1,100 @@
+package automaton_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newModulo6 builds an automation accepting binary numbers divisible by 6.
+func newModulo6(t *testing.T) *automaton.FiniteAutomation {
+	t.Helper()
+
+	transitions := make(transition.Transitions, 0)
+	states := []string{"R0", "R1", "R2", "R3", "R4", "R5"}
+	for r, state := range states {
+		transitions = append(transitions,
+			transition.Transition{StartState: state, Input: "0", ResultState: states[(2*r)%6]},
+			transition.Transition{StartState: state, Input: "1", ResultState: states[(2*r+1)%6]},
+		)
+	}
+
+	fa, err := automaton.NewFiniteAutomation(states, "R0", []string{"R0"}, transitions)
+	require.NoError(t, err)
+
+	return fa
+}
+
+func TestSubset(t *testing.T) {
+	t.Run("subset", func(t *testing.T) {
+		ok, witness := automaton.Subset(newModulo6(t), newModulo3(t))
+
+		assert.True(t, ok)
+		assert.Nil(t, witness)
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		ok, witness := automaton.Subset(newLowercase(t, "q"), newLowercase(t, ""))
+		assert.True(t, ok)
+		assert.Nil(t, witness)
+
+		ok, witness = automaton.Subset(newLowercase(t, ""), newLowercase(t, "q"))
+		assert.False(t, ok)
+		assert.Equal(t, []string{"q"}, witness)
+	})
+
+	t.Run("not a subset", func(t *testing.T) {
+		ok, witness := automaton.Subset(newModulo3(t), newModulo6(t))
+
+		assert.False(t, ok)
+		assert.Equal(t, []string{"1", "1"}, witness)
+	})
+
+	t.Run("inputs outside of the alphabet", func(t *testing.T) {
+		ok, witness := automaton.Subset(newPartial(t), newModulo3(t))
+
+		assert.False(t, ok)
+		assert.Equal(t, []string{"a", "b"}, witness)
+	})
+}
+
+func TestSubsetNFA(t *testing.T) {
+	t.Run("subset", func(t *testing.T) {
+		// Inputs ending with "bab" also end with "ab".
+		a, err := automaton.NewNFA([]string{"p0", "p1", "p2", "p3"}, []string{"p0"}, []string{"p3"}, transition.Transitions{
+			{StartState: "p0", Input: "a", ResultState: "p0"},
+			{StartState: "p0", Input: "b", ResultState: "p0"},
+			{StartState: "p0", Input: "b", ResultState: "p1"},
+			{StartState: "p1", Input: "a", ResultState: "p2"},
+			{StartState: "p2", Input: "b", ResultState: "p3"},
+		})
+		require.NoError(t, err)
+
+		ok, witness := automaton.SubsetNFA(a, newEndsWithAB(t))
+		assert.True(t, ok)
+		assert.Nil(t, witness)
+	})
+
+	t.Run("not a subset", func(t *testing.T) {
+		ok, witness := automaton.SubsetNFA(newModulo3(t).NFA(), newEndsWithAB(t))
+
+		assert.False(t, ok)
+		assert.Equal(t, []string{}, witness)
+
+		accepted, err := newModulo3(t).Accepts(witness...)
+		assert.NoError(t, err)
+		assert.True(t, accepted)
+	})
+
+	t.Run("agrees with subset on automata", func(t *testing.T) {
+		ok, witness := automaton.SubsetNFA(newModulo3(t).NFA(), newModulo6(t).NFA())
+		assert.False(t, ok)
+		assert.Equal(t, []string{"1", "1"}, witness)
+
+		ok, _ = automaton.SubsetNFA(newModulo6(t).NFA(), newModulo3(t).NFA())
+		assert.True(t, ok)
+	})
+}
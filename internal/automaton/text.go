@@ -0,0 +1,72 @@
+package automaton
+
+import (
+	"fmt"
+
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+// ExecuteString runs the automation on the runes of s.
+// Every rune is a valid input, the transition for the rune as a single input is used first,
+// then range transitions, then the default transition.
+func (fa *FiniteAutomation) ExecuteString(s string) (string, error) {
+	state := fa.InitialState
+	for i, r := range s {
+		next, err := state.TransitionRune(r)
+		if err != nil {
+			return "", fmt.Errorf("error executing automation at offset %d: %w", i, err)
+		}
+		state = next
+	}
+
+	return finalName(state)
+}
+
+// ExecuteBytes runs the automation on the bytes of b.
+// Every byte is a valid input, the transition for the byte as a single input is used first,
+// then range transitions containing the byte's value, then the default transition.
+func (fa *FiniteAutomation) ExecuteBytes(b []byte) (string, error) {
+	state := fa.InitialState
+	for i, c := range b {
+		next, err := state.TransitionByte(c)
+		if err != nil {
+			return "", fmt.Errorf("error executing automation at offset %d: %w", i, err)
+		}
+		state = next
+	}
+
+	return finalName(state)
+}
+
+// finalName returns the name of the state if it's a final state.
+func finalName(state *State) (string, error) {
+	if !state.IsFinal() {
+		return "", fmt.Errorf("state %s is not a final state", state.GetName())
+	}
+
+	return state.GetName(), nil
+}
+
+// RangeTransitions returns the range transitions, ordered by state and first rune.
+// The ranges of a state leading to the same state are grouped into one transition.
+func (fa *FiniteAutomation) RangeTransitions() transition.RangeTransitions {
+	transitions := make(transition.RangeTransitions, 0)
+	for _, state := range fa.States {
+		index := make(map[*State]int)
+		for _, edge := range state.ranges {
+			i, ok := index[edge.target]
+			if !ok {
+				i = len(transitions)
+				index[edge.target] = i
+				transitions = append(transitions, transition.RangeTransition{
+					StartState:  state.name,
+					Runes:       make(transition.RuneSet, 0),
+					ResultState: edge.target.name,
+				})
+			}
+			transitions[i].Runes = append(transitions[i].Runes, edge.RuneRange)
+		}
+	}
+
+	return transitions
+}
@@ -0,0 +1,120 @@
+package automaton_test
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newIdentifier builds an automaton accepting identifiers: a letter or underscore,
+// followed by letters, digits and underscores.
+func newIdentifier(t *testing.T) *automaton.FiniteAutomation {
+	t.Helper()
+
+	fa, err := automaton.NewFiniteAutomation([]string{"start", "ident"}, "start", []string{"ident"}, nil)
+	require.NoError(t, err)
+
+	letters := transition.RuneSetOf(unicode.Letter).Union(transition.Runes('_'))
+	require.NoError(t, fa.States.SetRange("start", letters, "ident"))
+	require.NoError(t, fa.States.SetRange("ident", letters.Union(transition.RuneSetOf(unicode.Digit)), "ident"))
+
+	return fa
+}
+
+// newLowercase returns an automation accepting words of one or two lowercase letters,
+// using range transitions only. A word starting with q is rejected if without is "q".
+func newLowercase(t *testing.T, without string) *automaton.FiniteAutomation {
+	t.Helper()
+
+	fa, err := automaton.NewFiniteAutomation([]string{"start", "one", "two"}, "start", []string{"one", "two"}, nil)
+	require.NoError(t, err)
+
+	letters := transition.Range('a', 'z')
+	require.NoError(t, fa.States.SetRange("start", letters.Subtract(transition.Runes([]rune(without)...)), "one"))
+	require.NoError(t, fa.States.SetRange("one", letters, "two"))
+
+	return fa
+}
+
+func TestFiniteAutomation_ExecuteString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa := newIdentifier(t)
+
+		for _, s := range []string{"x", "_tmp1", "héllo_wörld", "переменная"} {
+			state, err := fa.ExecuteString(s)
+			assert.NoError(t, err, s)
+			assert.Equal(t, "ident", state)
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		fa := newIdentifier(t)
+
+		_, err := fa.ExecuteString("")
+		assert.EqualError(t, err, "state start is not a final state")
+
+		_, err = fa.ExecuteString("ab-c")
+		assert.ErrorIs(t, err, automaton.ErrStateTransitionNotFound)
+		assert.ErrorContains(t, err, "offset 2")
+
+		_, err = fa.ExecuteString("1a")
+		assert.ErrorIs(t, err, automaton.ErrStateTransitionNotFound)
+	})
+
+	t.Run("single inputs", func(t *testing.T) {
+		state, err := newModulo3(t).ExecuteString("110")
+		assert.NoError(t, err)
+		assert.Equal(t, "S0", state)
+	})
+}
+
+func TestFiniteAutomation_ExecuteBytes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"start", "hex"}, "start", []string{"hex"}, nil)
+		require.NoError(t, err)
+		digits := transition.Range('0', '9').Union(transition.Range('a', 'f'))
+		require.NoError(t, fa.States.SetRange("start", digits, "hex"))
+		require.NoError(t, fa.States.SetRange("hex", digits, "hex"))
+
+		state, err := fa.ExecuteBytes([]byte("c0ffee"))
+		assert.NoError(t, err)
+		assert.Equal(t, "hex", state)
+
+		_, err = fa.ExecuteBytes([]byte{'a', 0xff})
+		assert.ErrorIs(t, err, automaton.ErrStateTransitionNotFound)
+	})
+}
+
+func TestFiniteAutomation_RangeTransitions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s", "a", "b"}, "s", []string{"a"}, nil)
+		require.NoError(t, err)
+		require.NoError(t, fa.States.SetRange("s", transition.Range('a', 'z'), "a"))
+		require.NoError(t, fa.States.SetRange("s", transition.Range('m', 'n'), "b"))
+
+		assert.Equal(t, transition.RangeTransitions{
+			{StartState: "s", Runes: transition.RuneSet{{Lo: 'a', Hi: 'l'}, {Lo: 'o', Hi: 'z'}}, ResultState: "a"},
+			{StartState: "s", Runes: transition.RuneSet{{Lo: 'm', Hi: 'n'}}, ResultState: "b"},
+		}, fa.RangeTransitions())
+	})
+
+	t.Run("fingerprint", func(t *testing.T) {
+		a := newIdentifier(t)
+		b := newIdentifier(t)
+		assert.Equal(t, a.Fingerprint(), b.Fingerprint())
+
+		require.NoError(t, b.States.SetRange("ident", transition.Runes('-'), "ident"))
+		assert.NotEqual(t, a.Fingerprint(), b.Fingerprint())
+	})
+
+	t.Run("reachability", func(t *testing.T) {
+		fa := newIdentifier(t)
+
+		assert.Empty(t, fa.UselessStates())
+		assert.Len(t, fa.Trim().RangeTransitions(), 2)
+	})
+}
@@ -0,0 +1,126 @@
+package automaton
+
+import (
+	"iter"
+	"slices"
+)
+
+// Words returns an iterator over the accepted inputs of at most maxLen symbols, in shortlex order:
+// shorter inputs first, inputs of the same length ordered by the alphabet order of their symbols.
+// The runes of range transitions come after the alphabet, in ascending order.
+func (fa *FiniteAutomation) Words(maxLen int) iter.Seq[[]string] {
+	return func(yield func([]string) bool) {
+		if maxLen < 0 {
+			return
+		}
+
+		syms := symbols(fa)
+		viable := fa.viable(maxLen)
+
+		// walk extends word from state with length symbols, yielding the accepted inputs.
+		var walk func(state *State, word []string, length int) bool
+		walk = func(state *State, word []string, length int) bool {
+			if length == 0 {
+				return yield(slices.Clone(word))
+			}
+
+			for _, sym := range syms {
+				next := state.move(sym.input, true)
+				if next == nil || !viable[length-1][next] {
+					continue
+				}
+
+				for i := 0; i < sym.size(); i++ {
+					if !walk(next, append(word, sym.at(i)), length-1) {
+						return false
+					}
+				}
+			}
+
+			return true
+		}
+
+		for length := 0; length <= maxLen; length++ {
+			if !viable[length][fa.InitialState] {
+				continue
+			}
+
+			if !walk(fa.InitialState, make([]string, 0, length), length) {
+				return
+			}
+		}
+	}
+}
+
+// ShortestAccepted returns the first accepted input in shortlex order.
+// It returns false if the automation accepts nothing.
+func (fa *FiniteAutomation) ShortestAccepted() ([]string, bool) {
+	return fa.shortest(func(s *State) bool { return accepts(s) })
+}
+
+// ShortestRejected returns the first rejected input over the alphabet in shortlex order.
+// It returns false if the automation accepts every input.
+func (fa *FiniteAutomation) ShortestRejected() ([]string, bool) {
+	return fa.shortest(func(s *State) bool { return !accepts(s) })
+}
+
+// viable returns, for every length k up to n, the states from which a final state can be reached
+// with exactly k symbols.
+func (fa *FiniteAutomation) viable(n int) []map[*State]bool {
+	syms := symbols(fa)
+	viable := make([]map[*State]bool, n+1)
+	for k := 0; k <= n; k++ {
+		viable[k] = make(map[*State]bool)
+		for _, state := range fa.States {
+			if k == 0 {
+				viable[k][state] = state.final
+				continue
+			}
+
+			for _, sym := range syms {
+				if next := state.move(sym.input, true); next != nil && viable[k-1][next] {
+					viable[k][state] = true
+					break
+				}
+			}
+		}
+	}
+
+	return viable
+}
+
+// shortest searches for the first input in shortlex order leading to a state matching found.
+// A nil state means the input was rejected by a missing transition, it has no successors.
+func (fa *FiniteAutomation) shortest(found func(*State) bool) ([]string, bool) {
+	syms := symbols(fa)
+
+	type entry struct {
+		state *State
+		word  []string
+	}
+
+	visited := map[*State]bool{fa.InitialState: true}
+	queue := []entry{{state: fa.InitialState, word: make([]string, 0)}}
+	for len(queue) > 0 {
+		e := queue[0]
+		queue = queue[1:]
+
+		if found(e.state) {
+			return e.word, true
+		}
+
+		if e.state == nil {
+			continue
+		}
+
+		for _, sym := range syms {
+			next := e.state.move(sym.input, true)
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, entry{state: next, word: append(slices.Clone(e.word), sym.input)})
+			}
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,146 @@
+package automaton_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFiniteAutomation_Words(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		words := slices.Collect(newModulo3(t).Words(4))
+
+		assert.Equal(t, [][]string{
+			{},
+			{"0"},
+			{"0", "0"},
+			{"1", "1"},
+			{"0", "0", "0"},
+			{"0", "1", "1"},
+			{"1", "1", "0"},
+			{"0", "0", "0", "0"},
+			{"0", "0", "1", "1"},
+			{"0", "1", "1", "0"},
+			{"1", "0", "0", "1"},
+			{"1", "1", "0", "0"},
+			{"1", "1", "1", "1"},
+		}, words)
+
+		for _, word := range words {
+			accepted, err := newModulo3(t).Accepts(word...)
+			assert.NoError(t, err)
+			assert.True(t, accepted)
+		}
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		words := make([]string, 0)
+		for word := range newLowercase(t, "").Words(2) {
+			words = append(words, strings.Join(word, ""))
+		}
+
+		assert.Len(t, words, 26+26*26)
+		assert.Equal(t, []string{"a", "b"}, words[:2])
+		assert.Equal(t, []string{"aa", "ab"}, words[26:28])
+		assert.Equal(t, "zz", words[len(words)-1])
+	})
+
+	t.Run("count matches", func(t *testing.T) {
+		fa := newModulo3(t)
+
+		count := 0
+		for word := range fa.Words(8) {
+			if len(word) == 8 {
+				count++
+			}
+		}
+
+		assert.Equal(t, fa.Count(8).Int64(), int64(count))
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+		words := make([][]string, 0)
+		for word := range newModulo3(t).Words(10) {
+			words = append(words, word)
+			if len(words) == 2 {
+				break
+			}
+		}
+
+		assert.Equal(t, [][]string{{}, {"0"}}, words)
+	})
+
+	t.Run("negative length", func(t *testing.T) {
+		assert.Empty(t, slices.Collect(newModulo3(t).Words(-1)))
+	})
+}
+
+func TestFiniteAutomation_ShortestAccepted(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		word, ok := newPartial(t).ShortestAccepted()
+
+		assert.True(t, ok)
+		assert.Equal(t, []string{"a", "b"}, word)
+	})
+
+	t.Run("range transitions", func(t *testing.T) {
+		word, ok := newLowercase(t, "").ShortestAccepted()
+		assert.True(t, ok)
+		assert.Equal(t, []string{"a"}, word)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		word, ok := newModulo3(t).ShortestAccepted()
+
+		assert.True(t, ok)
+		assert.Equal(t, []string{}, word)
+	})
+
+	t.Run("empty language", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s", "f"}, "s", []string{"f"}, transition.Transitions{
+			{StartState: "s", Input: "a", ResultState: "s"},
+		})
+		require.NoError(t, err)
+
+		word, ok := fa.ShortestAccepted()
+		assert.False(t, ok)
+		assert.Nil(t, word)
+	})
+}
+
+func TestFiniteAutomation_ShortestRejected(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		word, ok := newModulo3(t).ShortestRejected()
+
+		assert.True(t, ok)
+		assert.Equal(t, []string{"1"}, word)
+	})
+
+	t.Run("missing transition", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s"}, "s", []string{"s"}, transition.Transitions{
+			{StartState: "s", Input: "a", ResultState: "s"},
+		})
+		require.NoError(t, err)
+		fa.TransitionInputs = []string{"a", "b"}
+
+		word, ok := fa.ShortestRejected()
+		assert.True(t, ok)
+		assert.Equal(t, []string{"b"}, word)
+	})
+
+	t.Run("universal language", func(t *testing.T) {
+		fa, err := automaton.NewFiniteAutomation([]string{"s"}, "s", []string{"s"}, transition.Transitions{
+			{StartState: "s", Input: "a", ResultState: "s"},
+		})
+		require.NoError(t, err)
+
+		word, ok := fa.ShortestRejected()
+		assert.False(t, ok)
+		assert.Nil(t, word)
+	})
+}
@@ -0,0 +1,78 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+var (
+	ErrConflictingTransition = errors.New("error transition conflicts with an earlier transition with the same start state and input")
+)
+
+// ConflictingTransitionError describes two transitions with the same start state and input
+// but a different result state. It wraps ErrConflictingTransition.
+type ConflictingTransitionError struct {
+	// First is the earlier transition.
+	First transition.Transition
+
+	// FirstIndex is the index of the earlier transition.
+	FirstIndex int
+
+	// Second is the later transition, that would override the earlier one.
+	Second transition.Transition
+
+	// SecondIndex is the index of the later transition.
+	SecondIndex int
+}
+
+// Error describes both transitions and their indices.
+func (e *ConflictingTransitionError) Error() string {
+	return fmt.Sprintf("%s - transition %d %s --%s--> %s and transition %d %s --%s--> %s",
+		ErrConflictingTransition,
+		e.FirstIndex, e.First.StartState, e.First.Input, e.First.ResultState,
+		e.SecondIndex, e.Second.StartState, e.Second.Input, e.Second.ResultState,
+	)
+}
+
+// Unwrap returns ErrConflictingTransition.
+func (e *ConflictingTransitionError) Unwrap() error {
+	return ErrConflictingTransition
+}
+
+// ValidateTransitionConflicts validates that no two transitions have the same start state and input
+// with a different result state. Exact duplicates are not conflicts.
+func ValidateTransitionConflicts(transitions transition.Transitions) error {
+	errs := validateTransitionConflicts(transitions)
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// validateTransitionConflicts compares every transition to the latest earlier transition with the same start state and input.
+func validateTransitionConflicts(transitions transition.Transitions) []error {
+	type key struct {
+		start string
+		input string
+	}
+
+	errs := make([]error, 0)
+	seen := make(map[key]int)
+	for i, d := range transitions {
+		k := key{d.StartState, d.Input}
+		if j, ok := seen[k]; ok && transitions[j].ResultState != d.ResultState {
+			errs = append(errs, &ConflictingTransitionError{
+				First:       transitions[j],
+				FirstIndex:  j,
+				Second:      d,
+				SecondIndex: i,
+			})
+		}
+		seen[k] = i
+	}
+
+	return errs
+}
@@ -0,0 +1,44 @@
+package validation_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/validation"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTransitionConflicts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		transitions := transition.Transitions{
+			{StartState: "S0", Input: "0", ResultState: "S1"},
+			{StartState: "S0", Input: "1", ResultState: "S1"},
+			{StartState: "S0", Input: "0", ResultState: "S1"},
+		}
+
+		err := validation.ValidateTransitionConflicts(transitions)
+		assert.NoError(t, err)
+	})
+
+	t.Run("conflicting transitions", func(t *testing.T) {
+		transitions := transition.Transitions{
+			{StartState: "S0", Input: "0", ResultState: "S1"},
+			{StartState: "S0", Input: "1", ResultState: "S1"},
+			{StartState: "S0", Input: "0", ResultState: "S2"},
+		}
+
+		err := validation.ValidateTransitionConflicts(transitions)
+		assert.ErrorIs(t, err, validation.ErrConflictingTransition)
+		assert.EqualError(t, err, "error transition conflicts with an earlier transition with the same start state and input - transition 0 S0 --0--> S1 and transition 2 S0 --0--> S2")
+
+		var conflict *validation.ConflictingTransitionError
+		assert.True(t, errors.As(err, &conflict))
+		assert.Equal(t, &validation.ConflictingTransitionError{
+			First:       transition.Transition{StartState: "S0", Input: "0", ResultState: "S1"},
+			FirstIndex:  0,
+			Second:      transition.Transition{StartState: "S0", Input: "0", ResultState: "S2"},
+			SecondIndex: 2,
+		}, conflict)
+	})
+}
@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+var (
+	ErrUnreachableState  = errors.New("error state is not reachable from the initial state")
+	ErrUnproductiveState = errors.New("error state can not reach a final state")
+)
+
+// ValidateUsefulStates validates that every state is reachable from the initial state
+// and can reach a final state. Defaults maps states to the target of their default transition.
+func ValidateUsefulStates(states []string, initialState string, finalStates []string, transitions transition.Transitions, defaults map[string]string) error {
+	errs := validateUsefulStates(states, initialState, finalStates, transitions, defaults)
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// validateUsefulStates validates that the states are reachable and productive.
+func validateUsefulStates(states []string, initialState string, finalStates []string, transitions transition.Transitions, defaults map[string]string) []error {
+	reachable := reachableStates(initialState, transitions, defaults)
+	productive := productiveStates(finalStates, transitions, defaults)
+
+	errs := make([]error, 0)
+	checked := make(map[string]bool)
+	for _, q := range states {
+		if checked[q] {
+			continue
+		}
+		checked[q] = true
+
+		if !reachable[q] {
+			errs = append(errs, fmt.Errorf("%w - %s", ErrUnreachableState, q))
+		}
+
+		if !productive[q] {
+			errs = append(errs, fmt.Errorf("%w - %s", ErrUnproductiveState, q))
+		}
+	}
+
+	return errs
+}
+
+// reachableStates returns the set of states reachable from the initial state.
+func reachableStates(initialState string, transitions transition.Transitions, defaults map[string]string) map[string]bool {
+	successors := make(map[string][]string)
+	for _, t := range transitions {
+		successors[t.StartState] = append(successors[t.StartState], t.ResultState)
+	}
+	for start, result := range defaults {
+		successors[start] = append(successors[start], result)
+	}
+
+	return search([]string{initialState}, successors)
+}
+
+// productiveStates returns the set of states from which a final state can be reached.
+func productiveStates(finalStates []string, transitions transition.Transitions, defaults map[string]string) map[string]bool {
+	predecessors := make(map[string][]string)
+	for _, t := range transitions {
+		predecessors[t.ResultState] = append(predecessors[t.ResultState], t.StartState)
+	}
+	for start, result := range defaults {
+		predecessors[result] = append(predecessors[result], start)
+	}
+
+	return search(finalStates, predecessors)
+}
+
+// search returns the set of states reachable from the start states following edges.
+func search(start []string, edges map[string][]string) map[string]bool {
+	found := make(map[string]bool)
+	queue := make([]string, 0, len(start))
+	for _, q := range start {
+		if !found[q] {
+			found[q] = true
+			queue = append(queue, q)
+		}
+	}
+
+	for len(queue) > 0 {
+		q := queue[0]
+		queue = queue[1:]
+
+		for _, next := range edges[q] {
+			if !found[next] {
+				found[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return found
+}
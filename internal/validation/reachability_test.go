@@ -0,0 +1,60 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/validation"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUsefulStates(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		states := []string{"S0", "S1", "S2"}
+		transitions := transition.Transitions{
+			{StartState: "S0", Input: "0", ResultState: "S1"},
+			{StartState: "S1", Input: "0", ResultState: "S2"},
+		}
+
+		err := validation.ValidateUsefulStates(states, "S0", []string{"S2"}, transitions, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("unreachable state", func(t *testing.T) {
+		states := []string{"S0", "S1", "S2"}
+		transitions := transition.Transitions{
+			{StartState: "S0", Input: "0", ResultState: "S1"},
+			{StartState: "S2", Input: "0", ResultState: "S1"},
+		}
+
+		err := validation.ValidateUsefulStates(states, "S0", []string{"S1"}, transitions, nil)
+		assert.ErrorIs(t, err, validation.ErrUnreachableState)
+		assert.EqualError(t, err, "error state is not reachable from the initial state - S2")
+	})
+
+	t.Run("unproductive state", func(t *testing.T) {
+		states := []string{"S0", "S1", "S2"}
+		transitions := transition.Transitions{
+			{StartState: "S0", Input: "0", ResultState: "S1"},
+			{StartState: "S0", Input: "1", ResultState: "S2"},
+			{StartState: "S2", Input: "0", ResultState: "S2"},
+		}
+
+		err := validation.ValidateUsefulStates(states, "S0", []string{"S1"}, transitions, nil)
+		assert.ErrorIs(t, err, validation.ErrUnproductiveState)
+		assert.EqualError(t, err, "error state can not reach a final state - S2")
+	})
+}
+
+func TestValidateUsefulStates_Defaults(t *testing.T) {
+	t.Run("reachable through default transition", func(t *testing.T) {
+		states := []string{"S0", "S1", "S2"}
+		transitions := transition.Transitions{
+			{StartState: "S0", Input: "0", ResultState: "S1"},
+		}
+		defaults := map[string]string{"S1": "S2"}
+
+		err := validation.ValidateUsefulStates(states, "S0", []string{"S2"}, transitions, defaults)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,238 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+var (
+	ErrOverriddenTransition    = errors.New("warning transition overrides an earlier transition with the same start state and input")
+	ErrFinalStateNotReferenced = errors.New("warning final state has no incoming transitions")
+)
+
+// Severity describes how serious an issue is.
+type Severity int
+
+const (
+	// SeverityWarning is a problem that doesn't prevent building the automaton.
+	SeverityWarning Severity = iota
+
+	// SeverityError is a problem that prevents building the automaton.
+	SeverityError
+)
+
+// String returns the name of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return fmt.Sprintf("severity(%d)", int(s))
+	}
+}
+
+// Issue is a single problem found in an automaton definition.
+type Issue struct {
+	// Severity describes how serious the issue is.
+	Severity Severity
+
+	// Err describes the issue, it wraps one of the package errors.
+	Err error
+}
+
+// String formats the issue with its severity, which replaces the "error" or "warning" starting the message.
+// Unreachable states are errors or warnings depending on the definition, so their message alone can't tell.
+func (i Issue) String() string {
+	message := i.Err.Error()
+	if rest, ok := strings.CutPrefix(message, "error "); ok {
+		message = rest
+	} else if rest, ok := strings.CutPrefix(message, "warning "); ok {
+		message = rest
+	}
+
+	return fmt.Sprintf("%s: %s", i.Severity, message)
+}
+
+// Definition contains the components of an automaton to lint.
+type Definition struct {
+	States       []string
+	InitialState string
+	FinalStates  []string
+	Transitions  transition.Transitions
+
+	// Alphabet contains the declared inputs, nil if the inputs are derived from the transitions.
+	Alphabet []string
+
+	// Defaults maps states to the target of their default transition.
+	Defaults map[string]string
+
+	// RangeTransitions contains the transitions for ranges of runes.
+	RangeTransitions transition.RangeTransitions
+
+	// RequireUsefulStates reports unreachable and dead states as errors instead of warnings.
+	RequireUsefulStates bool
+
+	// Strict reports transitions overriding an earlier transition with a different result state as errors.
+	Strict bool
+}
+
+// ValidationReport contains every problem found in an automaton definition.
+type ValidationReport struct {
+	// Issues contains the problems, errors first.
+	Issues []Issue
+}
+
+// Lint validates the definition and collects every error and warning.
+func Lint(def Definition) *ValidationReport {
+	report := &ValidationReport{Issues: make([]Issue, 0)}
+
+	// Errors.
+	report.add(SeverityError, validateStates(def.States)...)
+	report.add(SeverityError, validateInitialState(def.InitialState, def.States)...)
+	report.add(SeverityError, validateFinalStates(def.FinalStates, def.States)...)
+	if len(def.RangeTransitions) > 0 {
+		// Range transitions are enough, transitions for single inputs are optional.
+		report.add(SeverityError, validateTransitionStates(def.Transitions, def.States)...)
+		report.add(SeverityError, validateRangeTransitions(def.RangeTransitions, def.States)...)
+	} else {
+		report.add(SeverityError, validateTransitions(def.Transitions, def.States)...)
+	}
+	if def.Alphabet != nil {
+		report.add(SeverityError, validateAlphabet(def.Alphabet, def.Transitions)...)
+	}
+	report.add(SeverityError, validateDefaultTransitions(def.Defaults, def.States)...)
+	if def.Strict {
+		report.add(SeverityError, validateTransitionConflicts(def.Transitions)...)
+	}
+
+	// Range transitions count as transitions for the state graph.
+	edges := slices.Clone(def.Transitions)
+	for _, t := range def.RangeTransitions {
+		edges = append(edges, transition.Transition{StartState: t.StartState, Input: t.Runes.String(), ResultState: t.ResultState})
+	}
+
+	// Useful states are errors only if they're required.
+	useful := validateUsefulStates(def.States, def.InitialState, def.FinalStates, edges, def.Defaults)
+	if def.RequireUsefulStates {
+		report.add(SeverityError, useful...)
+	}
+
+	// Warnings.
+	report.add(SeverityWarning, lintOverriddenTransitions(def.Transitions, def.Strict)...)
+	if !def.RequireUsefulStates {
+		for _, err := range useful {
+			if errors.Is(err, ErrUnreachableState) {
+				report.add(SeverityWarning, err)
+			}
+		}
+	}
+	report.add(SeverityWarning, lintFinalStatesNotReferenced(def.States, def.InitialState, def.FinalStates, edges, def.Defaults)...)
+
+	return report
+}
+
+// add adds issues with the severity to the report.
+func (r *ValidationReport) add(severity Severity, errs ...error) {
+	for _, err := range errs {
+		r.Issues = append(r.Issues, Issue{Severity: severity, Err: err})
+	}
+}
+
+// Errors returns the issues with error severity.
+func (r *ValidationReport) Errors() []Issue {
+	return r.filter(SeverityError)
+}
+
+// Warnings returns the issues with warning severity.
+func (r *ValidationReport) Warnings() []Issue {
+	return r.filter(SeverityWarning)
+}
+
+// HasErrors returns true if the report contains an error.
+func (r *ValidationReport) HasErrors() bool {
+	return len(r.Errors()) > 0
+}
+
+// Err joins all errors of the report, it returns nil if there are none.
+// Warnings are not included.
+func (r *ValidationReport) Err() error {
+	errs := make([]error, 0)
+	for _, issue := range r.Errors() {
+		errs = append(errs, issue.Err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// String formats the report, one issue per line.
+func (r *ValidationReport) String() string {
+	var sb strings.Builder
+	for _, issue := range r.Issues {
+		sb.WriteString(issue.String())
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// filter returns the issues with the severity.
+func (r *ValidationReport) filter(severity Severity) []Issue {
+	issues := make([]Issue, 0)
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues
+}
+
+// lintOverriddenTransitions finds transitions with the same start state and input as an earlier transition.
+// In strict mode, overrides with a different result state are skipped, as they are reported as errors.
+func lintOverriddenTransitions(transitions transition.Transitions, strict bool) []error {
+	type key struct {
+		start string
+		input string
+	}
+
+	errs := make([]error, 0)
+	seen := make(map[key]int)
+	for i, d := range transitions {
+		k := key{d.StartState, d.Input}
+		if j, ok := seen[k]; ok && (!strict || transitions[j].ResultState == d.ResultState) {
+			errs = append(errs, fmt.Errorf("%w - %s --%s--> %s (transition %d overrides transition %d)", ErrOverriddenTransition, d.StartState, d.Input, d.ResultState, i, j))
+		}
+		seen[k] = i
+	}
+
+	return errs
+}
+
+// lintFinalStatesNotReferenced finds final states, other than the initial state, without incoming transitions.
+// Final states not present in states are already reported as errors.
+func lintFinalStatesNotReferenced(states []string, initialState string, finalStates []string, transitions transition.Transitions, defaults map[string]string) []error {
+	referenced := map[string]bool{initialState: true}
+	for _, d := range transitions {
+		referenced[d.ResultState] = true
+	}
+	for _, result := range defaults {
+		referenced[result] = true
+	}
+
+	errs := make([]error, 0)
+	reported := make([]string, 0)
+	for _, f := range finalStates {
+		if !referenced[f] && slices.Contains(states, f) && !slices.Contains(reported, f) {
+			errs = append(errs, fmt.Errorf("%w - %s", ErrFinalStateNotReferenced, f))
+			reported = append(reported, f)
+		}
+	}
+
+	return errs
+}
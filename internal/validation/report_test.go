@@ -0,0 +1,180 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/validation"
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		report := validation.Lint(validation.Definition{
+			States:       []string{"S0", "S1"},
+			InitialState: "S0",
+			FinalStates:  []string{"S1"},
+			Transitions: transition.Transitions{
+				{StartState: "S0", Input: "0", ResultState: "S1"},
+			},
+		})
+
+		assert.Empty(t, report.Issues)
+		assert.False(t, report.HasErrors())
+		assert.NoError(t, report.Err())
+		assert.Equal(t, "", report.String())
+	})
+
+	t.Run("all errors", func(t *testing.T) {
+		report := validation.Lint(validation.Definition{
+			States:       []string{"S0", "S0", "S1", "S1"},
+			InitialState: "S0",
+			FinalStates:  []string{"S1", "S2"},
+			Transitions: transition.Transitions{
+				{StartState: "S0", Input: "0", ResultState: "S4"},
+				{StartState: "S5", Input: "2", ResultState: "S1"},
+			},
+			Alphabet: []string{"0", "1"},
+			Defaults: map[string]string{"S1": "S6"},
+		})
+
+		assert.True(t, report.HasErrors())
+		assert.Equal(t, `error: automaton states contains duplicate state - S0
+error: automaton states contains duplicate state - S1
+error: final states contains a state not present in automaton states - S2
+error: transitions contains a state not present in automaton states - S4
+error: transitions contains a state not present in automaton states - S5
+error: transitions contains an input not present in the alphabet - 2
+error: transitions contains a state not present in automaton states - S6
+warning: state is not reachable from the initial state - S1
+`, report.String())
+
+		err := report.Err()
+		assert.ErrorIs(t, err, validation.ErrDuplicateState)
+		assert.ErrorIs(t, err, validation.ErrInvalidFinalState)
+		assert.ErrorIs(t, err, validation.ErrInvalidTransitionState)
+		assert.ErrorIs(t, err, validation.ErrInvalidTransitionInput)
+		assert.NotErrorIs(t, err, validation.ErrUnreachableState)
+	})
+
+	t.Run("warnings", func(t *testing.T) {
+		report := validation.Lint(validation.Definition{
+			States:       []string{"S0", "S1", "S2"},
+			InitialState: "S0",
+			FinalStates:  []string{"S1", "S2"},
+			Transitions: transition.Transitions{
+				{StartState: "S0", Input: "0", ResultState: "S1"},
+				{StartState: "S0", Input: "0", ResultState: "S0"},
+			},
+		})
+
+		assert.False(t, report.HasErrors())
+		assert.Empty(t, report.Errors())
+		assert.NoError(t, report.Err())
+
+		warnings := report.Warnings()
+		assert.Len(t, warnings, 3)
+		assert.ErrorIs(t, warnings[0].Err, validation.ErrOverriddenTransition)
+		assert.EqualError(t, warnings[0].Err, "warning transition overrides an earlier transition with the same start state and input - S0 --0--> S0 (transition 1 overrides transition 0)")
+		assert.ErrorIs(t, warnings[1].Err, validation.ErrUnreachableState)
+		assert.ErrorIs(t, warnings[2].Err, validation.ErrFinalStateNotReferenced)
+		assert.EqualError(t, warnings[2].Err, "warning final state has no incoming transitions - S2")
+	})
+
+	t.Run("useful states required", func(t *testing.T) {
+		report := validation.Lint(validation.Definition{
+			States:       []string{"S0", "S1", "S2"},
+			InitialState: "S0",
+			FinalStates:  []string{"S1"},
+			Transitions: transition.Transitions{
+				{StartState: "S0", Input: "0", ResultState: "S1"},
+				{StartState: "S0", Input: "1", ResultState: "S2"},
+			},
+			RequireUsefulStates: true,
+		})
+
+		errs := report.Errors()
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0].Err, validation.ErrUnproductiveState)
+	})
+}
+
+func TestLint_RangeTransitions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		report := validation.Lint(validation.Definition{
+			States:       []string{"start", "number"},
+			InitialState: "start",
+			FinalStates:  []string{"number"},
+			RangeTransitions: transition.RangeTransitions{
+				{StartState: "start", Runes: transition.Range('0', '9'), ResultState: "number"},
+				{StartState: "number", Runes: transition.Range('0', '9'), ResultState: "number"},
+			},
+		})
+
+		assert.Empty(t, report.Issues)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		report := validation.Lint(validation.Definition{
+			States:       []string{"start", "number"},
+			InitialState: "start",
+			FinalStates:  []string{"number"},
+			RangeTransitions: transition.RangeTransitions{
+				{StartState: "start", Runes: transition.Range('0', '9'), ResultState: "digits"},
+				{StartState: "number", Runes: transition.RuneSet{}, ResultState: "number"},
+			},
+		})
+
+		errs := report.Errors()
+		assert.Len(t, errs, 2)
+		assert.ErrorIs(t, errs[0].Err, validation.ErrInvalidTransitionState)
+		assert.ErrorIs(t, errs[1].Err, validation.ErrEmptyRuneSet)
+	})
+}
+
+func TestSeverity_String(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, "warning", validation.SeverityWarning.String())
+		assert.Equal(t, "error", validation.SeverityError.String())
+		assert.Equal(t, "severity(5)", validation.Severity(5).String())
+	})
+}
+
+func TestIssue_String(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		issue := validation.Issue{Severity: validation.SeverityWarning, Err: validation.ErrFinalStateNotReferenced}
+		assert.Equal(t, "warning: final state has no incoming transitions", issue.String())
+	})
+
+	t.Run("error reused as warning", func(t *testing.T) {
+		issue := validation.Issue{Severity: validation.SeverityWarning, Err: validation.ErrUnreachableState}
+		assert.Equal(t, "warning: state is not reachable from the initial state", issue.String())
+		assert.ErrorIs(t, issue.Err, validation.ErrUnreachableState)
+	})
+}
+
+func TestLint_Strict(t *testing.T) {
+	t.Run("conflicts are errors", func(t *testing.T) {
+		report := validation.Lint(validation.Definition{
+			States:       []string{"S0", "S1"},
+			InitialState: "S0",
+			FinalStates:  []string{"S1"},
+			Transitions: transition.Transitions{
+				{StartState: "S0", Input: "0", ResultState: "S1"},
+				{StartState: "S0", Input: "0", ResultState: "S0"},
+				{StartState: "S0", Input: "1", ResultState: "S1"},
+				{StartState: "S0", Input: "1", ResultState: "S1"},
+			},
+			Strict: true,
+		})
+
+		errs := report.Errors()
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0].Err, validation.ErrConflictingTransition)
+
+		warnings := report.Warnings()
+		assert.Len(t, warnings, 1)
+		assert.ErrorIs(t, warnings[0].Err, validation.ErrOverriddenTransition)
+		assert.EqualError(t, warnings[0].Err, "warning transition overrides an earlier transition with the same start state and input - S0 --1--> S1 (transition 3 overrides transition 2)")
+	})
+}
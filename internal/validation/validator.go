@@ -3,6 +3,7 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 
 	"github.com/amitprajapati027/finite-automation/transition"
@@ -18,107 +19,191 @@ var (
 	ErrInvalidTransitions     = errors.New("error transitions is empty")
 	ErrInvalidTransitionState = errors.New("error transitions contains a state not present in automaton states")
 	ErrInvalidInput           = errors.New("error input contains an invalid value")
+	ErrInvalidTransitionInput = errors.New("error transitions contains an input not present in the alphabet")
+	ErrEmptyRuneSet           = errors.New("error range transition has no runes")
 )
 
 // ValidateAll performs comprehensive validation of all automaton components.
+// It returns the first error found, use Lint to collect all of them.
 func ValidateAll(states []string, initialState string, finalStates []string, transitions transition.Transitions) error {
 	// Perform all validations
-	err := validateStates(states)
-	if err != nil {
-		return err
+	checks := [][]error{
+		validateStates(states),
+		validateInitialState(initialState, states),
+		validateFinalStates(finalStates, states),
+		validateTransitions(transitions, states),
 	}
 
-	err = validateInitialState(initialState, states)
-	if err != nil {
-		return err
-	}
-
-	err = validateFinalStates(finalStates, states)
-	if err != nil {
-		return err
-	}
-
-	err = validateTransitions(transitions, states)
-	if err != nil {
-		return err
+	for _, errs := range checks {
+		if len(errs) > 0 {
+			return errs[0]
+		}
 	}
 
 	return nil
 }
 
 // validateStates validates the states.
-func validateStates(states []string) error {
+func validateStates(states []string) []error {
 	// Check if Q is empty.
 	if len(states) < 1 {
-		return ErrStatesNotDefined
+		return []error{ErrStatesNotDefined}
 	}
 
 	// Check for duplicate states.
+	errs := make([]error, 0)
 	statesMap := make(map[string]bool)
 	for _, q := range states {
 		if statesMap[q] {
-			return fmt.Errorf("%w - %s", ErrDuplicateState, q)
+			errs = append(errs, fmt.Errorf("%w - %s", ErrDuplicateState, q))
 		}
 
 		statesMap[q] = true
 	}
 
-	return nil
+	return errs
 }
 
 // validateInitialState validates the initial state
-func validateInitialState(initialState string, states []string) error {
+func validateInitialState(initialState string, states []string) []error {
 	// Check if initial state is empty.
 	if initialState == "" {
-		return ErrInitialStateNotDefined
+		return []error{ErrInitialStateNotDefined}
 	}
 
 	// Check if the initial state is contained in all states.
 	if !slices.Contains(states, initialState) {
-		return fmt.Errorf("%w - %s", ErrInvalidInitialState, initialState)
+		return []error{fmt.Errorf("%w - %s", ErrInvalidInitialState, initialState)}
 	}
 
 	return nil
 }
 
 // validateFinalStates validates the final states set F
-func validateFinalStates(finalStates []string, states []string) error {
+func validateFinalStates(finalStates []string, states []string) []error {
 	// Check if F is empty.
 	if len(finalStates) < 1 {
-		return ErrFinalStatesNotDefined
+		return []error{ErrFinalStatesNotDefined}
 	}
 
 	// Not check for duplicates in final states, as it doesn't affect the logic.
 	// Check if all final states are present in Q.
+	errs := make([]error, 0)
 	for _, f := range finalStates {
 		if !slices.Contains(states, f) {
-			return fmt.Errorf("%w - %s", ErrInvalidFinalState, f)
+			errs = append(errs, fmt.Errorf("%w - %s", ErrInvalidFinalState, f))
 		}
 	}
 
-	return nil
+	return errs
 }
 
 // validateTransitions validates the transition function Delta
-func validateTransitions(transitions []transition.Transition, states []string) error {
+func validateTransitions(transitions []transition.Transition, states []string) []error {
 	if len(transitions) < 1 {
-		return ErrInvalidTransitions
+		return []error{ErrInvalidTransitions}
 	}
 
+	return validateTransitionStates(transitions, states)
+}
+
+// validateTransitionStates validates the states of the transitions.
+func validateTransitionStates(transitions []transition.Transition, states []string) []error {
+	errs := make([]error, 0)
 	for _, d := range transitions {
 		// Check if all Delta states are present in Q.
 		if !slices.Contains(states, d.StartState) {
-			return fmt.Errorf("%w - %s", ErrInvalidTransitionState, d.StartState)
+			errs = append(errs, fmt.Errorf("%w - %s", ErrInvalidTransitionState, d.StartState))
 		}
 
 		if !slices.Contains(states, d.ResultState) {
-			return fmt.Errorf("%w - %s", ErrInvalidTransitionState, d.ResultState)
+			errs = append(errs, fmt.Errorf("%w - %s", ErrInvalidTransitionState, d.ResultState))
+		}
+	}
+
+	return errs
+}
+
+// ValidateAlphabet validates that all transition inputs are present in the alphabet.
+func ValidateAlphabet(alphabet []string, transitions transition.Transitions) error {
+	errs := validateAlphabet(alphabet, transitions)
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// validateAlphabet validates the transition inputs against the alphabet.
+func validateAlphabet(alphabet []string, transitions transition.Transitions) []error {
+	errs := make([]error, 0)
+	for _, d := range transitions {
+		if !slices.Contains(alphabet, d.Input) {
+			errs = append(errs, fmt.Errorf("%w - %s", ErrInvalidTransitionInput, d.Input))
+		}
+	}
+
+	return errs
+}
+
+// ValidateDefaultTransitions validates that the states of default transitions are present in all states.
+func ValidateDefaultTransitions(defaults map[string]string, states []string) error {
+	errs := validateDefaultTransitions(defaults, states)
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// validateDefaultTransitions validates the states of default transitions, in order of start state.
+func validateDefaultTransitions(defaults map[string]string, states []string) []error {
+	starts := slices.Sorted(maps.Keys(defaults))
+
+	errs := make([]error, 0)
+	for _, start := range starts {
+		if !slices.Contains(states, start) {
+			errs = append(errs, fmt.Errorf("%w - %s", ErrInvalidTransitionState, start))
+		}
+
+		if !slices.Contains(states, defaults[start]) {
+			errs = append(errs, fmt.Errorf("%w - %s", ErrInvalidTransitionState, defaults[start]))
 		}
 	}
 
+	return errs
+}
+
+// ValidateRangeTransitions validates the states and runes of range transitions.
+func ValidateRangeTransitions(transitions transition.RangeTransitions, states []string) error {
+	errs := validateRangeTransitions(transitions, states)
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
 	return nil
 }
 
+// validateRangeTransitions validates the states and runes of range transitions.
+func validateRangeTransitions(transitions transition.RangeTransitions, states []string) []error {
+	errs := make([]error, 0)
+	for _, t := range transitions {
+		if !slices.Contains(states, t.StartState) {
+			errs = append(errs, fmt.Errorf("%w - %s", ErrInvalidTransitionState, t.StartState))
+		}
+
+		if !slices.Contains(states, t.ResultState) {
+			errs = append(errs, fmt.Errorf("%w - %s", ErrInvalidTransitionState, t.ResultState))
+		}
+
+		if t.Runes.IsEmpty() {
+			errs = append(errs, fmt.Errorf("%w - %s", ErrEmptyRuneSet, t.StartState))
+		}
+	}
+
+	return errs
+}
+
 // ValidateInputs validates that all symbols in the alphabet have corresponding transitions
 func ValidateInputs(inputs []string, transitionInputs []string) error {
 	// Check if all Sigma inputs are present in Delta.
@@ -198,3 +198,78 @@ func TestValidateInputs(t *testing.T) {
 		assert.EqualError(t, err, "error input contains an invalid value - 2")
 	})
 }
+
+func TestValidateDefaultTransitions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		err := validation.ValidateDefaultTransitions(map[string]string{"S0": "S1"}, []string{"S0", "S1"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid start state", func(t *testing.T) {
+		err := validation.ValidateDefaultTransitions(map[string]string{"S2": "S1"}, []string{"S0", "S1"})
+		assert.ErrorIs(t, err, validation.ErrInvalidTransitionState)
+		assert.EqualError(t, err, "error transitions contains a state not present in automaton states - S2")
+	})
+
+	t.Run("invalid result state", func(t *testing.T) {
+		err := validation.ValidateDefaultTransitions(map[string]string{"S0": "S2"}, []string{"S0", "S1"})
+		assert.ErrorIs(t, err, validation.ErrInvalidTransitionState)
+		assert.EqualError(t, err, "error transitions contains a state not present in automaton states - S2")
+	})
+}
+
+func TestValidateAlphabet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		transitions := transition.Transitions{
+			{StartState: "S0", Input: "0", ResultState: "S1"},
+		}
+
+		err := validation.ValidateAlphabet([]string{"0", "1"}, transitions)
+		assert.NoError(t, err)
+	})
+
+	t.Run("input not in alphabet", func(t *testing.T) {
+		transitions := transition.Transitions{
+			{StartState: "S0", Input: "0", ResultState: "S1"},
+			{StartState: "S0", Input: "2", ResultState: "S1"},
+		}
+
+		err := validation.ValidateAlphabet([]string{"0", "1"}, transitions)
+		assert.ErrorIs(t, err, validation.ErrInvalidTransitionInput)
+		assert.EqualError(t, err, "error transitions contains an input not present in the alphabet - 2")
+	})
+}
+
+func TestValidateRangeTransitions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		err := validation.ValidateRangeTransitions(transition.RangeTransitions{
+			{StartState: "S0", Runes: transition.Range('a', 'z'), ResultState: "S1"},
+		}, []string{"S0", "S1"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		err := validation.ValidateRangeTransitions(transition.RangeTransitions{
+			{StartState: "S2", Runes: transition.Range('a', 'z'), ResultState: "S1"},
+		}, []string{"S0", "S1"})
+
+		assert.ErrorIs(t, err, validation.ErrInvalidTransitionState)
+	})
+
+	t.Run("empty runes", func(t *testing.T) {
+		err := validation.ValidateRangeTransitions(transition.RangeTransitions{
+			{StartState: "S0", Runes: transition.Range('z', 'a'), ResultState: "S1"},
+		}, []string{"S0", "S1"})
+
+		assert.ErrorIs(t, err, validation.ErrEmptyRuneSet)
+	})
+
+	t.Run("unnormalized empty runes", func(t *testing.T) {
+		err := validation.ValidateRangeTransitions(transition.RangeTransitions{
+			{StartState: "S0", Runes: transition.RuneSet{{Lo: 'z', Hi: 'a'}}, ResultState: "S1"},
+		}, []string{"S0", "S1"})
+
+		assert.ErrorIs(t, err, validation.ErrEmptyRuneSet)
+	})
+}
@@ -0,0 +1,224 @@
+package lexer
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/regex"
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+var (
+	ErrNoRules    = errors.New("error no token rules")
+	ErrEmptyMatch = errors.New("error token rule matches the empty input")
+)
+
+// Rule describes a token.
+type Rule struct {
+	// Name is the name of the token.
+	Name string
+
+	// Pattern is the regular expression matching the token, see regex.Parse.
+	Pattern string
+
+	// Priority decides between rules matching the same longest input, the highest wins.
+	// Rules with the same priority are ordered by registration.
+	Priority int
+
+	// Skip is true if matched tokens are dropped, for example for whitespace and comments.
+	Skip bool
+}
+
+// Lexer splits inputs into tokens. All rules are combined into a single FiniteAutomation,
+// whose final states are tagged with the rule they accept.
+type Lexer struct {
+	// rules contains the token rules.
+	rules []Rule
+
+	// fa is the combined automation.
+	fa *automaton.FiniteAutomation
+
+	// tags maps final states to the index of the rule they accept.
+	tags map[*automaton.State]int
+
+	// symbols contains the runes mentioned by the rules.
+	symbols map[rune]bool
+
+	// other is the input used for runes not mentioned by the rules, which are all matched the same way.
+	other string
+}
+
+// New combines the rules into a lexer.
+// Every state of the combined automation tracks the derivatives of all rules.
+func New(rules ...Rule) (*Lexer, error) {
+	if len(rules) == 0 {
+		return nil, ErrNoRules
+	}
+
+	l := &Lexer{
+		rules:   slices.Clone(rules),
+		tags:    make(map[*automaton.State]int),
+		symbols: make(map[rune]bool),
+	}
+
+	start := make([]*regex.Regexp, len(rules))
+	for i, rule := range rules {
+		r, err := regex.Parse(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing rule %s: %w", rule.Name, err)
+		}
+		if r.Nullable() {
+			return nil, fmt.Errorf("%w - %s", ErrEmptyMatch, rule.Name)
+		}
+		start[i] = r
+
+		for _, symbol := range r.Alphabet() {
+			c, _ := utf8.DecodeRuneInString(symbol)
+			l.symbols[c] = true
+		}
+	}
+
+	alphabet := make([]string, 0, len(l.symbols)+1)
+	for c := range l.symbols {
+		alphabet = append(alphabet, string(c))
+	}
+	slices.Sort(alphabet)
+	l.other = string(l.unmentioned())
+	alphabet = append(alphabet, l.other)
+
+	fa, err := l.combine(start, alphabet)
+	if err != nil {
+		return nil, err
+	}
+	l.fa = fa
+
+	return l, nil
+}
+
+// combine builds the automation whose states are the tuples of derivatives of the rules.
+func (l *Lexer) combine(start []*regex.Regexp, alphabet []string) (*automaton.FiniteAutomation, error) {
+	key := func(tuple []*regex.Regexp) string {
+		keys := make([]string, len(tuple))
+		for i, r := range tuple {
+			keys[i] = r.String()
+		}
+		return strings.Join(keys, "\x00")
+	}
+
+	tuples := [][]*regex.Regexp{start}
+	names := map[string]string{key(start): "T0"}
+	transitions := make(transition.Transitions, 0)
+	for i := 0; i < len(tuples); i++ {
+		for _, symbol := range alphabet {
+			next := make([]*regex.Regexp, len(tuples[i]))
+			dead := true
+			for j, r := range tuples[i] {
+				next[j] = r.Derivative(symbol)
+				dead = dead && next[j].IsEmpty()
+			}
+			if dead {
+				continue
+			}
+
+			k := key(next)
+			if _, ok := names[k]; !ok {
+				names[k] = fmt.Sprintf("T%d", len(tuples))
+				tuples = append(tuples, next)
+			}
+
+			transitions = append(transitions, transition.Transition{
+				StartState:  names[key(tuples[i])],
+				Input:       symbol,
+				ResultState: names[k],
+			})
+		}
+	}
+
+	states := make([]string, len(tuples))
+	finals := make([]string, 0)
+	tags := make(map[string]int)
+	for i, tuple := range tuples {
+		states[i] = fmt.Sprintf("T%d", i)
+		if rule, ok := l.accepted(tuple); ok {
+			finals = append(finals, states[i])
+			tags[states[i]] = rule
+		}
+	}
+
+	fa, err := automaton.NewFiniteAutomation(states, "T0", finals, transitions)
+	if err != nil {
+		return nil, fmt.Errorf("error combining rules: %w", err)
+	}
+	fa.TransitionInputs = alphabet
+
+	for state := range fa.AllStates() {
+		if rule, ok := tags[state.GetName()]; ok {
+			l.tags[state] = rule
+		}
+	}
+
+	return fa, nil
+}
+
+// accepted returns the index of the rule with the highest priority among the nullable derivatives.
+func (l *Lexer) accepted(tuple []*regex.Regexp) (int, bool) {
+	best := -1
+	for i, r := range tuple {
+		if r.Nullable() && (best < 0 || l.rules[i].Priority > l.rules[best].Priority) {
+			best = i
+		}
+	}
+
+	return best, best >= 0
+}
+
+// unmentioned returns a rune not mentioned by the rules.
+func (l *Lexer) unmentioned() rune {
+	c := utf8.MaxRune
+	for l.symbols[c] {
+		c--
+	}
+
+	return c
+}
+
+// Rules returns the token rules.
+func (l *Lexer) Rules() []Rule {
+	return slices.Clone(l.rules)
+}
+
+// Automaton returns the combined automation. Runes not mentioned by the rules are all mapped
+// to the last input of its alphabet.
+func (l *Lexer) Automaton() *automaton.FiniteAutomation {
+	return l.fa
+}
+
+// Tag returns the rule accepted by a final state of the combined automation.
+func (l *Lexer) Tag(state string) (Rule, bool) {
+	for s, rule := range l.tags {
+		if s.GetName() == state {
+			return l.rules[rule], true
+		}
+	}
+
+	return Rule{}, false
+}
+
+// next returns the state reached from s with the rune, or nil if there is none.
+func (l *Lexer) next(s *automaton.State, c rune) *automaton.State {
+	symbol := l.other
+	if l.symbols[c] {
+		symbol = string(c)
+	}
+
+	next, err := s.Transition(symbol)
+	if err != nil {
+		return nil
+	}
+
+	return next
+}
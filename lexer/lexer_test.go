@@ -0,0 +1,78 @@
+package lexer_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/lexer"
+	"github.com/amitprajapati027/finite-automation/regex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCalculator builds a lexer for arithmetic with keywords, skipping whitespace and comments.
+func newCalculator(t *testing.T) *lexer.Lexer {
+	t.Helper()
+
+	l, err := lexer.New(
+		lexer.Rule{Name: "LET", Pattern: "let", Priority: 1},
+		lexer.Rule{Name: "IDENT", Pattern: "[a-z_][a-z0-9_]*"},
+		lexer.Rule{Name: "NUMBER", Pattern: "[0-9]+"},
+		lexer.Rule{Name: "ASSIGN", Pattern: "="},
+		lexer.Rule{Name: "EQUAL", Pattern: "=="},
+		lexer.Rule{Name: "OP", Pattern: "[\\-+*/]"},
+		lexer.Rule{Name: "WS", Pattern: "[ \t\n]+", Skip: true},
+		lexer.Rule{Name: "COMMENT", Pattern: "#[^\n]*", Skip: true},
+	)
+	require.NoError(t, err)
+
+	return l
+}
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		l := newCalculator(t)
+
+		assert.Len(t, l.Rules(), 8)
+		assert.Equal(t, "T0", l.Automaton().InitialState.GetName())
+		assert.NotEmpty(t, l.Automaton().FinalStates())
+	})
+
+	t.Run("no rules", func(t *testing.T) {
+		_, err := lexer.New()
+		assert.ErrorIs(t, err, lexer.ErrNoRules)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := lexer.New(lexer.Rule{Name: "GROUP", Pattern: "(a"})
+		assert.ErrorIs(t, err, regex.ErrSyntax)
+		assert.ErrorContains(t, err, "GROUP")
+	})
+
+	t.Run("empty match", func(t *testing.T) {
+		_, err := lexer.New(lexer.Rule{Name: "SPACES", Pattern: " *"})
+		assert.ErrorIs(t, err, lexer.ErrEmptyMatch)
+	})
+}
+
+func TestLexer_Tag(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		l := newCalculator(t)
+		fa := l.Automaton()
+
+		// "let" is also an identifier, the keyword has a higher priority.
+		state, err := fa.Execute("l", "e", "t")
+		require.NoError(t, err)
+		rule, ok := l.Tag(state)
+		assert.True(t, ok)
+		assert.Equal(t, "LET", rule.Name)
+
+		state, err = fa.Execute("l", "e")
+		require.NoError(t, err)
+		rule, ok = l.Tag(state)
+		assert.True(t, ok)
+		assert.Equal(t, "IDENT", rule.Name)
+
+		_, ok = l.Tag(fa.InitialState.GetName())
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,189 @@
+package lexer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	ErrNoMatch = errors.New("error no token rule matches the input")
+)
+
+// Position is a location in the input.
+type Position struct {
+	// Offset is the byte offset, starting at 0.
+	Offset int
+
+	// Line is the line number, starting at 1.
+	Line int
+
+	// Column is the rune offset in the line, starting at 1.
+	Column int
+}
+
+// String returns the position as line:column.
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Token is a part of the input matched by a rule.
+type Token struct {
+	// Name is the name of the rule.
+	Name string
+
+	// Text is the matched input.
+	Text string
+
+	// Pos is the position of the first rune.
+	Pos Position
+}
+
+// Scanner reads tokens from a rune stream, matching the longest possible input at every position.
+type Scanner struct {
+	// lexer contains the rules.
+	lexer *Lexer
+
+	// reader is the rune stream.
+	reader io.RuneReader
+
+	// buffer contains the runes read but not consumed yet.
+	buffer []rune
+
+	// sizes contains the size in bytes of the runes of the buffer.
+	sizes []int
+
+	// err is the error that ended the stream, nil until then.
+	err error
+
+	// pos is the position of the first rune of the buffer.
+	pos Position
+}
+
+// Scan returns a scanner reading tokens from r, which is buffered unless it's an io.RuneReader.
+func (l *Lexer) Scan(r io.Reader) *Scanner {
+	reader, ok := r.(io.RuneReader)
+	if !ok {
+		reader = bufio.NewReader(r)
+	}
+
+	return l.ScanRunes(reader)
+}
+
+// ScanRunes returns a scanner reading tokens from a rune stream.
+func (l *Lexer) ScanRunes(r io.RuneReader) *Scanner {
+	return &Scanner{
+		lexer:  l,
+		reader: r,
+		buffer: make([]rune, 0),
+		sizes:  make([]int, 0),
+		pos:    Position{Line: 1, Column: 1},
+	}
+}
+
+// Tokenize returns all tokens of s, except skipped ones.
+func (l *Lexer) Tokenize(s string) ([]Token, error) {
+	scanner := l.Scan(strings.NewReader(s))
+	tokens := make([]Token, 0)
+	for {
+		token, err := scanner.Next()
+		if errors.Is(err, io.EOF) {
+			return tokens, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+}
+
+// Next returns the next token, except skipped ones. It returns io.EOF at the end of the input,
+// errors of the stream, and ErrNoMatch when no rule matches the input at the current position,
+// in which case the scanner doesn't move.
+func (s *Scanner) Next() (Token, error) {
+	for {
+		token, rule, err := s.scan()
+		if err != nil {
+			return Token{}, err
+		}
+
+		if !s.lexer.rules[rule].Skip {
+			return token, nil
+		}
+	}
+}
+
+// scan returns the longest token at the current position and the index of its rule.
+func (s *Scanner) scan() (Token, int, error) {
+	state := s.lexer.fa.InitialState
+	length, rule := 0, -1
+	for i := 0; ; i++ {
+		c, ok := s.peek(i)
+		if !ok {
+			break
+		}
+
+		state = s.lexer.next(state, c)
+		if state == nil {
+			break
+		}
+
+		if r, ok := s.lexer.tags[state]; ok {
+			length, rule = i+1, r
+		}
+	}
+
+	if rule < 0 {
+		if len(s.buffer) == 0 || (s.err != nil && !errors.Is(s.err, io.EOF)) {
+			return Token{}, -1, s.err
+		}
+		return Token{}, -1, fmt.Errorf("%w - at %s", ErrNoMatch, s.pos)
+	}
+
+	token := Token{
+		Name: s.lexer.rules[rule].Name,
+		Text: string(s.buffer[:length]),
+		Pos:  s.pos,
+	}
+	s.consume(length)
+
+	return token, rule, nil
+}
+
+// peek returns the i-th rune of the buffer, reading from the stream if needed.
+// It returns false once the stream ended.
+func (s *Scanner) peek(i int) (rune, bool) {
+	for len(s.buffer) <= i {
+		if s.err != nil {
+			return 0, false
+		}
+
+		c, size, err := s.reader.ReadRune()
+		if err != nil {
+			s.err = err
+			return 0, false
+		}
+		s.buffer = append(s.buffer, c)
+		s.sizes = append(s.sizes, size)
+	}
+
+	return s.buffer[i], true
+}
+
+// consume removes n runes from the buffer and moves the position past them.
+func (s *Scanner) consume(n int) {
+	for i, c := range s.buffer[:n] {
+		s.pos.Offset += s.sizes[i]
+		if c == '\n' {
+			s.pos.Line++
+			s.pos.Column = 1
+		} else {
+			s.pos.Column++
+		}
+	}
+
+	s.buffer = s.buffer[n:]
+	s.sizes = s.sizes[n:]
+}
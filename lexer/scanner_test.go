@@ -0,0 +1,113 @@
+package lexer_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/amitprajapati027/finite-automation/lexer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// names returns the names of the tokens.
+func names(tokens []lexer.Token) []string {
+	result := make([]string, len(tokens))
+	for i, token := range tokens {
+		result[i] = token.Name
+	}
+
+	return result
+}
+
+func TestLexer_Tokenize(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tokens, err := newCalculator(t).Tokenize("let x = 42 # answer\nx == letter-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"LET", "IDENT", "ASSIGN", "NUMBER", "IDENT", "EQUAL", "IDENT", "OP", "NUMBER"}, names(tokens))
+		assert.Equal(t, lexer.Token{Name: "NUMBER", Text: "42", Pos: lexer.Position{Offset: 8, Line: 1, Column: 9}}, tokens[3])
+		assert.Equal(t, lexer.Token{Name: "EQUAL", Text: "==", Pos: lexer.Position{Offset: 22, Line: 2, Column: 3}}, tokens[5])
+
+		// Maximal munch prefers the longer identifier over the keyword.
+		assert.Equal(t, "letter", tokens[6].Text)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		tokens, err := newCalculator(t).Tokenize("  # nothing")
+		assert.NoError(t, err)
+		assert.Empty(t, tokens)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		_, err := newCalculator(t).Tokenize("x = 1\ny = $")
+		assert.ErrorIs(t, err, lexer.ErrNoMatch)
+		assert.EqualError(t, err, "error no token rule matches the input - at 2:5")
+	})
+
+	t.Run("unicode", func(t *testing.T) {
+		l, err := lexer.New(
+			lexer.Rule{Name: "WORD", Pattern: "[^ ]+"},
+			lexer.Rule{Name: "WS", Pattern: " ", Skip: true},
+		)
+		require.NoError(t, err)
+
+		tokens, err := l.Tokenize("héllo wörld")
+		require.NoError(t, err)
+
+		assert.Equal(t, "wörld", tokens[1].Text)
+		assert.Equal(t, lexer.Position{Offset: 7, Line: 1, Column: 7}, tokens[1].Pos)
+	})
+}
+
+func TestScanner_Next(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Read one byte at a time, so tokens span several reads.
+		scanner := newCalculator(t).Scan(iotest.OneByteReader(strings.NewReader("abc == 12")))
+
+		expected := []string{"abc", "==", "12"}
+		for _, text := range expected {
+			token, err := scanner.Next()
+			require.NoError(t, err)
+			assert.Equal(t, text, token.Text)
+		}
+
+		_, err := scanner.Next()
+		assert.ErrorIs(t, err, io.EOF)
+		_, err = scanner.Next()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		scanner := newCalculator(t).Scan(strings.NewReader("a $"))
+
+		_, err := scanner.Next()
+		require.NoError(t, err)
+
+		// The scanner doesn't move past the unmatched input.
+		_, err = scanner.Next()
+		assert.ErrorIs(t, err, lexer.ErrNoMatch)
+		_, err = scanner.Next()
+		assert.ErrorIs(t, err, lexer.ErrNoMatch)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		failure := errors.New("connection reset")
+		scanner := newCalculator(t).Scan(io.MultiReader(strings.NewReader("a "), iotest.ErrReader(failure)))
+
+		token, err := scanner.Next()
+		require.NoError(t, err)
+		assert.Equal(t, "a", token.Text)
+
+		_, err = scanner.Next()
+		assert.ErrorIs(t, err, failure)
+	})
+}
+
+func TestPosition_String(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, "3:14", lexer.Position{Offset: 40, Line: 3, Column: 14}.String())
+	})
+}
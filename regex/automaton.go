@@ -0,0 +1,138 @@
+package regex
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/transition"
+)
+
+// Compile parses the pattern and builds an automation accepting the inputs it matches.
+// See Regexp.Automaton for the alphabet.
+func Compile(pattern string, alphabet ...string) (*automaton.FiniteAutomation, error) {
+	return CompileContext(context.Background(), pattern, automaton.Limits{}, alphabet...)
+}
+
+// CompileContext is like Compile, but stops when a limit is hit or the context is done.
+// Use it for untrusted patterns, whose automata can be exponentially large.
+func CompileContext(ctx context.Context, pattern string, limits automaton.Limits, alphabet ...string) (*automaton.FiniteAutomation, error) {
+	r, err := Parse(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.AutomatonContext(ctx, limits, alphabet...)
+}
+
+// Automaton builds an automation accepting the inputs over the alphabet matched by the expression.
+// Without an alphabet, the symbols mentioned by the expression are used, see Alphabet, and the runes
+// it doesn't mention take a range transition, so ~a accepts "b" and . accepts any rune.
+//
+// Each state is a distinct derivative of the expression and is named after its pattern.
+// Derivatives matching nothing are left out, so the automation is partial.
+func (r *Regexp) Automaton(alphabet ...string) (*automaton.FiniteAutomation, error) {
+	return r.AutomatonContext(context.Background(), automaton.Limits{}, alphabet...)
+}
+
+// AutomatonContext is like Automaton, but stops when a limit is hit or the context is done.
+// Without an alphabet, every listed symbol counts as a transition against the limits.
+func (r *Regexp) AutomatonContext(ctx context.Context, limits automaton.Limits, alphabet ...string) (*automaton.FiniteAutomation, error) {
+	budget := automaton.NewBudget(ctx, limits)
+	if err := budget.AddState(); err != nil {
+		return nil, fmt.Errorf("error building automation for %s: %w", r.key, err)
+	}
+
+	// Runes not mentioned by the expression are all matched the same way, other stands for them.
+	var others transition.RuneSet
+	other := ""
+	if len(alphabet) == 0 {
+		var err error
+		if alphabet, err = r.alphabet(budget); err != nil {
+			return nil, fmt.Errorf("error listing alphabet of %s: %w", r.key, err)
+		}
+
+		others = unmentioned(alphabet)
+		if !others.IsEmpty() {
+			other = string(others[len(others)-1].Hi)
+		}
+	}
+
+	states := []*Regexp{r}
+	seen := map[string]bool{r.key: true}
+	transitions := make(transition.Transitions, 0)
+	ranges := make(transition.RangeTransitions, 0)
+	for i := 0; i < len(states); i++ {
+		symbols := alphabet
+		if other != "" {
+			symbols = append(slices.Clip(alphabet), other)
+		}
+
+		for _, symbol := range symbols {
+			next := states[i].Derivative(symbol)
+			if next.kind == kindEmpty {
+				continue
+			}
+
+			if !seen[next.key] {
+				if err := budget.AddState(); err != nil {
+					return nil, fmt.Errorf("error building automation for %s: %w", r.key, err)
+				}
+				seen[next.key] = true
+				states = append(states, next)
+			}
+
+			if err := budget.AddTransition(); err != nil {
+				return nil, fmt.Errorf("error building automation for %s: %w", r.key, err)
+			}
+
+			if symbol == other {
+				ranges = append(ranges, transition.RangeTransition{StartState: states[i].key, Runes: others, ResultState: next.key})
+				continue
+			}
+
+			transitions = append(transitions, transition.Transition{
+				StartState:  states[i].key,
+				Input:       symbol,
+				ResultState: next.key,
+			})
+		}
+	}
+
+	names := make([]string, len(states))
+	finals := make([]string, 0)
+	for i, state := range states {
+		names[i] = state.key
+		if state.nullable {
+			finals = append(finals, state.key)
+		}
+	}
+
+	fa, err := automaton.NewFiniteAutomation(names, r.key, finals, transitions)
+	if err != nil {
+		return nil, fmt.Errorf("error building automation for %s: %w", r.key, err)
+	}
+	fa.TransitionInputs = slices.Clone(alphabet)
+	for _, t := range ranges {
+		if err := fa.States.SetRange(t.StartState, t.Runes, t.ResultState); err != nil {
+			return nil, fmt.Errorf("error building automation for %s: %w", r.key, err)
+		}
+	}
+
+	return fa, nil
+}
+
+// unmentioned returns the runes that aren't symbols of the alphabet, surrogates excluded.
+func unmentioned(alphabet []string) transition.RuneSet {
+	mentioned := make([]transition.RuneRange, 0, len(alphabet)+1)
+	mentioned = append(mentioned, transition.RuneRange{Lo: 0xD800, Hi: 0xDFFF})
+	for _, symbol := range alphabet {
+		if c, size := utf8.DecodeRuneInString(symbol); size == len(symbol) && utf8.ValidString(symbol) {
+			mentioned = append(mentioned, transition.RuneRange{Lo: c, Hi: c})
+		}
+	}
+
+	return transition.Range(0, utf8.MaxRune).Subtract(transition.NewRuneSet(mentioned...))
+}
@@ -0,0 +1,158 @@
+package regex_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/regex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa, err := regex.Compile("(ab)*")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"(ab)*", "b(ab)*"}, fa.StateNames())
+		assert.Equal(t, []string{"(ab)*"}, fa.FinalStates())
+		assert.Equal(t, []string{"a", "b"}, fa.Alphabet())
+
+		state, err := fa.Execute("a", "b", "a", "b")
+		assert.NoError(t, err)
+		assert.Equal(t, "(ab)*", state)
+
+		_, err = fa.Execute("a")
+		assert.Error(t, err)
+	})
+
+	t.Run("unmentioned runes", func(t *testing.T) {
+		fa, err := regex.Compile("~a")
+		require.NoError(t, err)
+
+		_, err = fa.Execute("b")
+		assert.NoError(t, err)
+
+		fa, err = regex.Compile(".")
+		require.NoError(t, err)
+
+		assert.Empty(t, fa.Alphabet())
+		state, err := fa.Execute("x")
+		assert.NoError(t, err)
+		assert.Equal(t, "()", state)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := regex.Compile("(ab")
+		assert.ErrorIs(t, err, regex.ErrSyntax)
+	})
+}
+
+func TestRegexp_Automaton(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r := regex.MustParse("(a|b)*&~(.*bb.*)")
+		fa, err := r.Automaton()
+		require.NoError(t, err)
+
+		// The derivatives are similar up to normalization, so the construction terminates.
+		assert.Len(t, fa.States, 2)
+		for word := range fa.Words(6) {
+			assert.True(t, r.Match(word...), word)
+		}
+		for word := range fa.Complement().Words(6) {
+			assert.False(t, r.Match(word...), word)
+		}
+	})
+
+	t.Run("alphabet", func(t *testing.T) {
+		fa, err := regex.MustParse("~a").Automaton("a", "b")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a", "b"}, fa.Alphabet())
+
+		for _, input := range [][]string{{}, {"b"}, {"a", "a"}, {"a", "b"}} {
+			ok, err := fa.Accepts(input...)
+			assert.NoError(t, err)
+			assert.True(t, ok, input)
+		}
+
+		ok, err := fa.Accepts("a")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("unmentioned runes", func(t *testing.T) {
+		for _, pattern := range []string{"~a", ".", "[^ab]c", "a.*"} {
+			r := regex.MustParse(pattern)
+			fa, err := r.Automaton()
+			require.NoError(t, err, pattern)
+
+			for _, input := range []string{"", "a", "b", "z", "ac", "zc", "ab", "\u00e9", "\U0001F600c"} {
+				_, err := fa.Execute(strings.Split(input, "")...)
+				assert.Equal(t, r.MatchString(input), err == nil, "%s %q", pattern, input)
+
+				ok, err := fa.Accepts(strings.Split(input, "")...)
+				assert.NoError(t, err)
+				assert.Equal(t, r.MatchString(input), ok, "%s %q", pattern, input)
+			}
+		}
+	})
+
+	t.Run("matches nothing", func(t *testing.T) {
+		fa, err := regex.MustParse("a&b").Automaton()
+		require.NoError(t, err)
+
+		// Normalization is syntactic, so a&b is a state even though it matches nothing.
+		assert.Equal(t, []string{"a&b"}, fa.StateNames())
+		assert.True(t, fa.IsEmpty())
+	})
+}
+
+func TestCompileContext(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		fa, err := regex.CompileContext(context.Background(), "(ab)*", automaton.Limits{MaxStates: 2})
+		require.NoError(t, err)
+
+		assert.Len(t, fa.States, 2)
+	})
+
+	t.Run("budget exceeded", func(t *testing.T) {
+		// Inputs whose 12th symbol from the end is "a" need 4096 states.
+		_, err := regex.CompileContext(context.Background(), ".*a"+strings.Repeat(".", 11), automaton.Limits{MaxStates: 100}, "a", "b")
+		assert.ErrorIs(t, err, automaton.ErrBudgetExceeded)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := regex.CompileContext(ctx, "(ab)*", automaton.Limits{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("large alphabet", func(t *testing.T) {
+		_, err := regex.CompileContext(context.Background(), "[\u0001-\u3FFF]", automaton.Limits{MaxTransitions: 1000})
+
+		var exceeded *automaton.BudgetExceededError
+		assert.ErrorAs(t, err, &exceeded)
+		assert.Equal(t, automaton.LimitTransitions, exceeded.Limit)
+	})
+
+	t.Run("large alphabet canceled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := regex.CompileContext(ctx, "[\u0001-\U0010FFFF]", automaton.Limits{})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := regex.CompileContext(context.Background(), "(ab", automaton.Limits{})
+		assert.ErrorIs(t, err, regex.ErrSyntax)
+	})
+}
@@ -0,0 +1,70 @@
+package regex
+
+// Derivative returns the expression matching the rest of the inputs matched by r that start with symbol.
+func (r *Regexp) Derivative(symbol string) *Regexp {
+	switch r.kind {
+	case kindClass:
+		if r.class.matches(symbol) {
+			return epsilon
+		}
+		return empty
+
+	case kindConcat:
+		// d(rs) = d(r)s | d(s) if r is nullable.
+		head, tail := r.subs[0], newConcat(r.subs[1:]...)
+		derivative := newConcat(head.Derivative(symbol), tail)
+		if head.nullable {
+			derivative = newOr(derivative, tail.Derivative(symbol))
+		}
+		return derivative
+
+	case kindStar:
+		// d(r*) = d(r)r*.
+		return newConcat(r.subs[0].Derivative(symbol), r)
+
+	case kindOr:
+		derivatives := make([]*Regexp, len(r.subs))
+		for i, sub := range r.subs {
+			derivatives[i] = sub.Derivative(symbol)
+		}
+		return newOr(derivatives...)
+
+	case kindAnd:
+		derivatives := make([]*Regexp, len(r.subs))
+		for i, sub := range r.subs {
+			derivatives[i] = sub.Derivative(symbol)
+		}
+		return newAnd(derivatives...)
+
+	case kindNot:
+		return newNot(r.subs[0].Derivative(symbol))
+
+	default:
+		// The empty expression and epsilon match nothing after a symbol.
+		return empty
+	}
+}
+
+// Match returns true if the expression matches the symbols.
+func (r *Regexp) Match(symbols ...string) bool {
+	for _, symbol := range symbols {
+		r = r.Derivative(symbol)
+		if r.kind == kindEmpty {
+			return false
+		}
+	}
+
+	return r.nullable
+}
+
+// MatchString returns true if the expression matches the runes of s.
+func (r *Regexp) MatchString(s string) bool {
+	for _, c := range s {
+		r = r.Derivative(string(c))
+		if r.kind == kindEmpty {
+			return false
+		}
+	}
+
+	return r.nullable
+}
@@ -0,0 +1,67 @@
+package regex_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/regex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexp_Derivative(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r := regex.MustParse("ab*|ac")
+
+		assert.Equal(t, "b*|c", r.Derivative("a").String())
+		assert.Equal(t, "[]", r.Derivative("b").String())
+		assert.Equal(t, "b*", r.Derivative("a").Derivative("b").String())
+	})
+
+	t.Run("intersection and complement", func(t *testing.T) {
+		r := regex.MustParse("~(ab)&a.")
+
+		assert.Equal(t, ".&~b", r.Derivative("a").String())
+		assert.Equal(t, "[]", r.Derivative("a").Derivative("b").String())
+	})
+}
+
+func TestRegexp_Match(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r := regex.MustParse("(ab)*")
+
+		assert.True(t, r.Match())
+		assert.True(t, r.Match("a", "b", "a", "b"))
+		assert.False(t, r.Match("a", "b", "a"))
+		assert.False(t, r.Match("ab"))
+	})
+
+	t.Run("wildcard", func(t *testing.T) {
+		r := regex.MustParse("a.c")
+
+		assert.True(t, r.Match("a", "ab", "c"))
+		assert.False(t, r.Match("a", "c"))
+	})
+}
+
+func TestRegexp_MatchString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := map[string]map[string]bool{
+			"[a-z]+@[a-z]+": {"me@host": true, "@host": false, "me@": false},
+			// Any input containing "admin", except exactly "admin".
+			".*admin.*&~(admin)": {"admins": true, "xadmin": true, "admin": false, "user": false},
+			// Inputs of the form a*b* without "ab" are a* or b*.
+			"a*b*&~(.*ab.*)":      {"": true, "aaa": true, "bb": true, "ab": false, "aab": false},
+			"~(.*secret.*)":       {"public": true, "top secret": false, "secre": true},
+			"~(a|b)&[ab]":         {"a": false, "b": false, "": false},
+			"(a|b)*&~(.*bb.*)&.+": {"abab": true, "abba": false, "": false},
+			"[^0-9]*":             {"abc": true, "a1": false},
+			"hé.l?o":              {"héllo": true, "hélo": true, "hello": false},
+		}
+
+		for pattern, inputs := range tests {
+			r := regex.MustParse(pattern)
+			for input, expected := range inputs {
+				assert.Equal(t, expected, r.MatchString(input), "%s %q", pattern, input)
+			}
+		}
+	})
+}
@@ -0,0 +1,279 @@
+package regex
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+var (
+	ErrSyntax = errors.New("error invalid regular expression")
+)
+
+// Parse parses a regular expression. Every rune of the pattern is a symbol.
+//
+// The syntax, from loosest to tightest binding, is:
+//
+//	r|s   union
+//	r&s   intersection
+//	rs    concatenation
+//	~r    complement
+//	r* r+ r?  repetition
+//
+// Atoms are literal runes, escaped runes such as \*, the wildcard ., classes such as [a-z] or [^0-9],
+// () for the empty input and [] for nothing. Parentheses group expressions.
+func Parse(pattern string) (*Regexp, error) {
+	p := &parser{pattern: pattern}
+
+	r, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos < len(p.pattern) {
+		return nil, p.errorf("unexpected %q", p.peek())
+	}
+
+	return r, nil
+}
+
+// MustParse is like Parse but panics if the pattern is invalid.
+func MustParse(pattern string) *Regexp {
+	r, err := Parse(pattern)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// parser is a recursive descent parser for regular expressions.
+type parser struct {
+	// pattern is the regular expression being parsed.
+	pattern string
+
+	// pos is the byte offset of the next rune.
+	pos int
+}
+
+// parseOr parses a union of intersections.
+func (p *parser) parseOr() (*Regexp, error) {
+	subs := make([]*Regexp, 0)
+	for {
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, r)
+
+		if !p.accept('|') {
+			return newOr(subs...), nil
+		}
+	}
+}
+
+// parseAnd parses an intersection of concatenations.
+func (p *parser) parseAnd() (*Regexp, error) {
+	subs := make([]*Regexp, 0)
+	for {
+		r, err := p.parseConcat()
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, r)
+
+		if !p.accept('&') {
+			return newAnd(subs...), nil
+		}
+	}
+}
+
+// parseConcat parses a possibly empty concatenation.
+func (p *parser) parseConcat() (*Regexp, error) {
+	subs := make([]*Regexp, 0)
+	for p.pos < len(p.pattern) {
+		switch p.peek() {
+		case '|', '&', ')':
+			return newConcat(subs...), nil
+		}
+
+		r, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, r)
+	}
+
+	return newConcat(subs...), nil
+}
+
+// parseNot parses a complement or a repetition.
+func (p *parser) parseNot() (*Regexp, error) {
+	if p.accept('~') {
+		r, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return newNot(r), nil
+	}
+
+	return p.parseRepeat()
+}
+
+// parseRepeat parses an atom followed by any number of repetition operators.
+func (p *parser) parseRepeat() (*Regexp, error) {
+	r, err := p.parseAtom()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch {
+		case p.accept('*'):
+			r = newStar(r)
+		case p.accept('+'):
+			r = newConcat(r, newStar(r))
+		case p.accept('?'):
+			r = newOr(r, epsilon)
+		default:
+			return r, nil
+		}
+	}
+}
+
+// parseAtom parses a literal, a wildcard, a class or a group.
+func (p *parser) parseAtom() (*Regexp, error) {
+	if p.pos >= len(p.pattern) {
+		return nil, p.errorf("missing expression")
+	}
+
+	start := p.pos
+	c := p.next()
+	switch c {
+	case '(':
+		r, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(')') {
+			return nil, p.errorf("missing ) for ( at offset %d", start)
+		}
+		return r, nil
+
+	case '[':
+		return p.parseClass(start)
+
+	case '.':
+		return newClass(class{negated: true}), nil
+
+	case '\\':
+		return p.parseEscape()
+
+	case '*', '+', '?':
+		p.pos = start
+		return nil, p.errorf("missing expression before %q", c)
+
+	case ']', ')', '|', '&':
+		p.pos = start
+		return nil, p.errorf("unexpected %q", c)
+	}
+
+	return newClass(class{ranges: []runeRange{{c, c}}}), nil
+}
+
+// parseEscape parses the rune after a backslash as a literal.
+func (p *parser) parseEscape() (*Regexp, error) {
+	c, err := p.escaped()
+	if err != nil {
+		return nil, err
+	}
+
+	return newClass(class{ranges: []runeRange{{c, c}}}), nil
+}
+
+// parseClass parses a class after its opening bracket.
+func (p *parser) parseClass(start int) (*Regexp, error) {
+	c := class{ranges: make([]runeRange, 0)}
+	c.negated = p.accept('^')
+
+	for !p.accept(']') {
+		if p.pos >= len(p.pattern) {
+			return nil, p.errorf("missing ] for [ at offset %d", start)
+		}
+
+		lo, err := p.classRune()
+		if err != nil {
+			return nil, err
+		}
+
+		hi := lo
+		if p.accept('-') {
+			hi, err = p.classRune()
+			if err != nil {
+				return nil, err
+			}
+			if hi < lo {
+				return nil, p.errorf("invalid range %q-%q", lo, hi)
+			}
+		}
+
+		c.ranges = append(c.ranges, runeRange{lo, hi})
+	}
+
+	return newClass(c), nil
+}
+
+// classRune parses a possibly escaped rune inside a class.
+func (p *parser) classRune() (rune, error) {
+	if p.pos >= len(p.pattern) {
+		return 0, p.errorf("unexpected end of pattern")
+	}
+
+	if p.accept('\\') {
+		return p.escaped()
+	}
+
+	switch c := p.peek(); c {
+	case '[', ']', '^', '-':
+		return 0, p.errorf("unescaped %q in class", c)
+	}
+
+	return p.next(), nil
+}
+
+// escaped returns the rune after a backslash.
+func (p *parser) escaped() (rune, error) {
+	if p.pos >= len(p.pattern) {
+		return 0, p.errorf("trailing backslash")
+	}
+
+	return p.next(), nil
+}
+
+// peek returns the next rune without consuming it.
+func (p *parser) peek() rune {
+	c, _ := utf8.DecodeRuneInString(p.pattern[p.pos:])
+	return c
+}
+
+// next consumes and returns the next rune.
+func (p *parser) next() rune {
+	c, size := utf8.DecodeRuneInString(p.pattern[p.pos:])
+	p.pos += size
+	return c
+}
+
+// accept consumes the next rune if it's c.
+func (p *parser) accept(c rune) bool {
+	if p.pos < len(p.pattern) && p.peek() == c {
+		p.pos += utf8.RuneLen(c)
+		return true
+	}
+
+	return false
+}
+
+// errorf returns a syntax error at the current offset.
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w - %s at offset %d", ErrSyntax, fmt.Sprintf(format, args...), p.pos)
+}
@@ -0,0 +1,67 @@
+package regex_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/regex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := map[string]string{
+			"ab|c":       "ab|c",
+			"c|ab|c":     "ab|c",
+			"a&(b|c)":    "a&(b|c)",
+			"~a*b":       "~a*b",
+			"(~a)*":      "(~a)*",
+			"~~a":        "a",
+			"a+":         "aa*",
+			"a?":         "()|a",
+			"(a*)*":      "a*",
+			"a()b":       "ab",
+			"a[]b":       "[]",
+			"[]*":        "()",
+			"[c-da-b]":   "[a-d]",
+			"[^0-9]":     "[^0-9]",
+			"\\*\\.":     "\\*\\.",
+			"[\\]\\-]":   "[\\-\\]]",
+			".":          ".",
+			"a|~[]":      ".*",
+			"a&~[]":      "a",
+			"a|~a":       ".*",
+			"a*&~(a*)":   "[]",
+			"~.*":        "[]",
+			"":           "()",
+			"a|":         "()|a",
+			"(ab)(cd)e":  "abcde",
+			"(a|b)(c&d)": "(a|b)(c&d)",
+		}
+
+		for pattern, expected := range tests {
+			r, err := regex.Parse(pattern)
+			require.NoError(t, err, pattern)
+			assert.Equal(t, expected, r.String(), pattern)
+
+			// The canonical pattern parses to the same expression.
+			again, err := regex.Parse(r.String())
+			require.NoError(t, err, pattern)
+			assert.Equal(t, r.String(), again.String(), pattern)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, pattern := range []string{"(a", "a)", "[a", "*a", "a|*", "~", "a\\", "[b-a]", "[a-]", "~|a", "[^]]"} {
+			_, err := regex.Parse(pattern)
+			assert.ErrorIs(t, err, regex.ErrSyntax, pattern)
+		}
+	})
+}
+
+func TestMustParse(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, "ab", regex.MustParse("ab").String())
+		assert.Panics(t, func() { regex.MustParse("(") })
+	})
+}
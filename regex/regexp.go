@@ -0,0 +1,417 @@
+package regex
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+)
+
+// kind is the kind of a regular expression node.
+type kind int
+
+const (
+	// kindEmpty matches nothing.
+	kindEmpty kind = iota
+
+	// kindEpsilon matches the empty input.
+	kindEpsilon
+
+	// kindClass matches a single symbol of a character class.
+	kindClass
+
+	// kindConcat matches its subexpressions one after the other.
+	kindConcat
+
+	// kindStar matches zero or more repetitions of its subexpression.
+	kindStar
+
+	// kindOr matches any of its subexpressions.
+	kindOr
+
+	// kindAnd matches all of its subexpressions.
+	kindAnd
+
+	// kindNot matches everything its subexpression doesn't match.
+	kindNot
+)
+
+// Regexp is a regular expression over symbols, matched with Brzozowski derivatives.
+// Regular expressions are immutable and normalized: unions and intersections are flattened,
+// sorted and deduplicated, and trivial cases such as ~~r, r&[] or r|~r are simplified,
+// so similar expressions have the same String.
+type Regexp struct {
+	// kind is the kind of node.
+	kind kind
+
+	// class contains the symbols matched by a class node.
+	class class
+
+	// subs contains the subexpressions.
+	subs []*Regexp
+
+	// key is the canonical string of the expression.
+	key string
+
+	// nullable is true if the expression matches the empty input.
+	nullable bool
+}
+
+var (
+	// empty matches nothing.
+	empty = &Regexp{kind: kindEmpty, key: "[]"}
+
+	// epsilon matches the empty input.
+	epsilon = &Regexp{kind: kindEpsilon, key: "()", nullable: true}
+
+	// universal matches every input.
+	universal = newStar(newClass(class{negated: true}))
+)
+
+// String returns the canonical pattern of the expression.
+func (r *Regexp) String() string {
+	return r.key
+}
+
+// Nullable returns true if the expression matches the empty input.
+func (r *Regexp) Nullable() bool {
+	return r.nullable
+}
+
+// IsEmpty returns true if the expression is [], which matches nothing.
+// Normalization only detects simple cases, so other expressions such as a&b may match nothing too.
+func (r *Regexp) IsEmpty() bool {
+	return r.kind == kindEmpty
+}
+
+// Alphabet returns the symbols mentioned by the expression, in order of appearance.
+// Literals and the runes of classes are included, so large ranges give large alphabets.
+// Symbols outside of the alphabet are all matched the same way.
+func (r *Regexp) Alphabet() []string {
+	// Without a budget listing the alphabet can't fail.
+	alphabet, _ := r.alphabet(nil)
+	return alphabet
+}
+
+// alphabet returns the symbols mentioned by the expression. With a budget, every symbol is recorded
+// as a transition, so large alphabets count against the limits and listing them can be canceled.
+func (r *Regexp) alphabet(budget *automaton.Budget) ([]string, error) {
+	alphabet := make([]string, 0)
+	seen := make(map[string]bool)
+	var visit func(*Regexp) error
+	visit = func(r *Regexp) error {
+		if r.kind == kindClass {
+			for _, rr := range r.class.ranges {
+				for c := rr.lo; c <= rr.hi; c++ {
+					s := string(c)
+					if seen[s] {
+						continue
+					}
+
+					if budget != nil {
+						if err := budget.AddTransition(); err != nil {
+							return err
+						}
+					}
+					seen[s] = true
+					alphabet = append(alphabet, s)
+				}
+			}
+		}
+
+		for _, sub := range r.subs {
+			if err := visit(sub); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := visit(r); err != nil {
+		return nil, err
+	}
+
+	return alphabet, nil
+}
+
+// newClass returns an expression matching a single symbol of the class.
+func newClass(c class) *Regexp {
+	c = c.normalize()
+	if !c.negated && len(c.ranges) == 0 {
+		return empty
+	}
+
+	return &Regexp{kind: kindClass, class: c, key: c.String()}
+}
+
+// newConcat returns an expression matching the subexpressions one after the other.
+func newConcat(subs ...*Regexp) *Regexp {
+	flat := make([]*Regexp, 0, len(subs))
+	for _, sub := range subs {
+		switch sub.kind {
+		case kindEmpty:
+			return empty
+		case kindEpsilon:
+			continue
+		case kindConcat:
+			flat = append(flat, sub.subs...)
+		default:
+			flat = append(flat, sub)
+		}
+	}
+
+	switch len(flat) {
+	case 0:
+		return epsilon
+	case 1:
+		return flat[0]
+	}
+
+	keys := make([]string, len(flat))
+	nullable := true
+	for i, sub := range flat {
+		keys[i] = wrap(sub, precedenceConcat)
+		nullable = nullable && sub.nullable
+	}
+
+	return &Regexp{kind: kindConcat, subs: flat, key: strings.Join(keys, ""), nullable: nullable}
+}
+
+// newStar returns an expression matching zero or more repetitions of sub.
+func newStar(sub *Regexp) *Regexp {
+	switch sub.kind {
+	case kindEmpty, kindEpsilon:
+		return epsilon
+	case kindStar:
+		return sub
+	}
+
+	return &Regexp{kind: kindStar, subs: []*Regexp{sub}, key: wrap(sub, precedencePostfix) + "*", nullable: true}
+}
+
+// newOr returns an expression matching any of the subexpressions.
+func newOr(subs ...*Regexp) *Regexp {
+	flat := make([]*Regexp, 0, len(subs))
+	for _, sub := range subs {
+		switch {
+		case sub.kind == kindEmpty:
+			continue
+		case sub.kind == kindOr:
+			flat = append(flat, sub.subs...)
+		case isUniversal(sub):
+			return sub
+		default:
+			flat = append(flat, sub)
+		}
+	}
+
+	return newSet(kindOr, flat, empty, "|", precedenceOr)
+}
+
+// newAnd returns an expression matching all of the subexpressions.
+func newAnd(subs ...*Regexp) *Regexp {
+	flat := make([]*Regexp, 0, len(subs))
+	for _, sub := range subs {
+		switch {
+		case sub.kind == kindEmpty:
+			return empty
+		case sub.kind == kindAnd:
+			flat = append(flat, sub.subs...)
+		case isUniversal(sub):
+			continue
+		default:
+			flat = append(flat, sub)
+		}
+	}
+
+	return newSet(kindAnd, flat, universal, "&", precedenceAnd)
+}
+
+// newSet returns a union or intersection of the subexpressions, sorted and without duplicates.
+func newSet(k kind, subs []*Regexp, identity *Regexp, separator string, precedence int) *Regexp {
+	slices.SortFunc(subs, func(a, b *Regexp) int { return strings.Compare(a.key, b.key) })
+	subs = slices.CompactFunc(subs, func(a, b *Regexp) bool { return a.key == b.key })
+
+	// r|~r matches every input and r&~r matches nothing.
+	keys := make(map[string]bool, len(subs))
+	for _, sub := range subs {
+		keys[sub.key] = true
+	}
+	for _, sub := range subs {
+		if sub.kind == kindNot && keys[sub.subs[0].key] {
+			if k == kindOr {
+				return universal
+			}
+			return empty
+		}
+	}
+
+	switch len(subs) {
+	case 0:
+		return identity
+	case 1:
+		return subs[0]
+	}
+
+	patterns := make([]string, len(subs))
+	nullable := k == kindAnd
+	for i, sub := range subs {
+		patterns[i] = wrap(sub, precedence)
+		if k == kindOr {
+			nullable = nullable || sub.nullable
+		} else {
+			nullable = nullable && sub.nullable
+		}
+	}
+
+	return &Regexp{kind: k, subs: subs, key: strings.Join(patterns, separator), nullable: nullable}
+}
+
+// newNot returns an expression matching everything sub doesn't match.
+func newNot(sub *Regexp) *Regexp {
+	switch {
+	case sub.kind == kindEmpty:
+		return universal
+	case isUniversal(sub):
+		return empty
+	case sub.kind == kindNot:
+		return sub.subs[0]
+	}
+
+	return &Regexp{kind: kindNot, subs: []*Regexp{sub}, key: "~" + wrap(sub, precedenceNot), nullable: !sub.nullable}
+}
+
+// isUniversal returns true if the expression is .*, which matches every input.
+func isUniversal(r *Regexp) bool {
+	return r.kind == kindStar && r.subs[0].kind == kindClass && r.subs[0].key == "."
+}
+
+// Operator precedences, from loosest to tightest.
+const (
+	precedenceOr = iota
+	precedenceAnd
+	precedenceConcat
+	precedenceNot
+	precedencePostfix
+	precedenceAtom
+)
+
+// precedence returns how tightly the expression binds when printed.
+func (r *Regexp) precedence() int {
+	switch r.kind {
+	case kindOr:
+		return precedenceOr
+	case kindAnd:
+		return precedenceAnd
+	case kindConcat:
+		return precedenceConcat
+	case kindNot:
+		return precedenceNot
+	case kindStar:
+		return precedencePostfix
+	default:
+		return precedenceAtom
+	}
+}
+
+// wrap returns the key of the expression, in parentheses if it binds looser than precedence.
+func wrap(r *Regexp, precedence int) string {
+	if r.precedence() <= precedence && r.precedence() != precedenceAtom {
+		return "(" + r.key + ")"
+	}
+
+	return r.key
+}
+
+// runeRange is an inclusive range of runes.
+type runeRange struct {
+	lo rune
+	hi rune
+}
+
+// class is a set of single-rune symbols.
+type class struct {
+	// ranges contains the runes of the class, sorted and merged once normalized.
+	ranges []runeRange
+
+	// negated is true if the class matches every symbol not in ranges.
+	negated bool
+}
+
+// normalize sorts and merges the ranges.
+func (c class) normalize() class {
+	ranges := slices.Clone(c.ranges)
+	slices.SortFunc(ranges, func(a, b runeRange) int { return int(a.lo - b.lo) })
+
+	merged := make([]runeRange, 0, len(ranges))
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r.lo <= merged[n-1].hi+1 {
+			merged[n-1].hi = max(merged[n-1].hi, r.hi)
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	return class{ranges: merged, negated: c.negated}
+}
+
+// matches returns true if the symbol is in the class.
+// Symbols that are not a single rune only match negated classes.
+func (c class) matches(symbol string) bool {
+	r, size := utf8.DecodeRuneInString(symbol)
+	in := false
+	if symbol != "" && size == len(symbol) {
+		for _, rr := range c.ranges {
+			if rr.lo <= r && r <= rr.hi {
+				in = true
+				break
+			}
+		}
+	}
+
+	return in != c.negated
+}
+
+// String returns the pattern of the class.
+func (c class) String() string {
+	if c.negated && len(c.ranges) == 0 {
+		return "."
+	}
+
+	if !c.negated && len(c.ranges) == 1 && c.ranges[0].lo == c.ranges[0].hi {
+		return escape(c.ranges[0].lo, specials)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[")
+	if c.negated {
+		sb.WriteString("^")
+	}
+	for _, r := range c.ranges {
+		sb.WriteString(escape(r.lo, classSpecials))
+		if r.hi > r.lo {
+			sb.WriteString("-")
+			sb.WriteString(escape(r.hi, classSpecials))
+		}
+	}
+	sb.WriteString("]")
+
+	return sb.String()
+}
+
+// Characters with a special meaning, which must be escaped to be matched literally.
+const (
+	specials      = `\.[]()|&~*+?`
+	classSpecials = `\[]^-`
+)
+
+// escape returns the rune, escaped if it's one of the special characters.
+func escape(r rune, special string) string {
+	if strings.ContainsRune(special, r) {
+		return `\` + string(r)
+	}
+
+	return string(r)
+}
@@ -0,0 +1,48 @@
+package regex_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/regex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexp_Nullable(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := map[string]bool{
+			"a":      false,
+			"a*":     true,
+			"a*b*":   true,
+			"a*b":    false,
+			"a|()":   true,
+			"a*&b*":  true,
+			"a*&b":   false,
+			"~a":     true,
+			"~a*":    false,
+			"[]":     false,
+			"()":     true,
+			"~(a|b)": true,
+		}
+
+		for pattern, expected := range tests {
+			assert.Equal(t, expected, regex.MustParse(pattern).Nullable(), pattern)
+		}
+	})
+}
+
+func TestRegexp_Alphabet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, []string{"b", "a", "x", "y", "z"}, regex.MustParse("ba*(a|[x-z])").Alphabet())
+		assert.Equal(t, []string{"b", "a"}, regex.MustParse("[^b].a").Alphabet())
+		assert.Empty(t, regex.MustParse("()").Alphabet())
+	})
+}
+
+func TestRegexp_IsEmpty(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.True(t, regex.MustParse("a[]").IsEmpty())
+		assert.True(t, regex.MustParse("a&~a").IsEmpty())
+		assert.False(t, regex.MustParse("()").IsEmpty())
+		assert.False(t, regex.MustParse("a").IsEmpty())
+	})
+}
@@ -0,0 +1,66 @@
+package symbolic
+
+// Algebra is an effective Boolean algebra: predicates of type P over values of type D,
+// closed under And, Or and Not, with a decidable satisfiability check.
+// Predicates are used as transition labels instead of single inputs.
+type Algebra[P, D any] interface {
+	// True returns the predicate holding for every value.
+	True() P
+
+	// False returns the predicate holding for no value.
+	False() P
+
+	// And returns the predicate holding for values where both a and b hold.
+	And(a, b P) P
+
+	// Or returns the predicate holding for values where a or b holds.
+	Or(a, b P) P
+
+	// Not returns the predicate holding for values where p doesn't hold.
+	Not(p P) P
+
+	// IsSat returns true if the predicate holds for some value.
+	IsSat(p P) bool
+
+	// Contains returns true if the predicate holds for the value.
+	Contains(p P, value D) bool
+}
+
+// Equivalent returns true if a and b hold for the same values.
+func Equivalent[P, D any](algebra Algebra[P, D], a, b P) bool {
+	return !algebra.IsSat(algebra.And(a, algebra.Not(b))) && !algebra.IsSat(algebra.And(b, algebra.Not(a)))
+}
+
+// minterm is a satisfiable combination of predicates and negated predicates.
+type minterm[P any] struct {
+	// predicate holds exactly where the combination holds.
+	predicate P
+
+	// included describes which predicates are in the combination, the others are negated.
+	included []bool
+}
+
+// minterms splits the values into the satisfiable combinations of the predicates.
+// Values in the same minterm satisfy exactly the same predicates.
+func minterms[P, D any](algebra Algebra[P, D], predicates []P) []minterm[P] {
+	terms := []minterm[P]{{predicate: algebra.True(), included: make([]bool, 0, len(predicates))}}
+	for _, p := range predicates {
+		next := make([]minterm[P], 0, 2*len(terms))
+		for _, term := range terms {
+			if with := algebra.And(term.predicate, p); algebra.IsSat(with) {
+				next = append(next, minterm[P]{predicate: with, included: append(clone(term.included), true)})
+			}
+			if without := algebra.And(term.predicate, algebra.Not(p)); algebra.IsSat(without) {
+				next = append(next, minterm[P]{predicate: without, included: append(clone(term.included), false)})
+			}
+		}
+		terms = next
+	}
+
+	return terms
+}
+
+// clone returns a copy of included with room for one more predicate.
+func clone(included []bool) []bool {
+	return append(make([]bool, 0, len(included)+1), included...)
+}
@@ -0,0 +1,18 @@
+package symbolic_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/symbolic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEquivalent(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		algebra := symbolic.IntervalAlgebra{}
+
+		assert.True(t, symbolic.Equivalent[symbolic.Intervals, int64](algebra, symbolic.Greater(9), symbolic.AtLeast(10)))
+		assert.True(t, symbolic.Equivalent[symbolic.Intervals, int64](algebra, algebra.Or(symbolic.Less(0), symbolic.AtLeast(0)), algebra.True()))
+		assert.False(t, symbolic.Equivalent[symbolic.Intervals, int64](algebra, symbolic.Greater(9), symbolic.Greater(10)))
+	})
+}
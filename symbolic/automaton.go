@@ -0,0 +1,615 @@
+package symbolic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+)
+
+// Transition describes a transition taken for every value satisfying the guard.
+type Transition[P any] struct {
+	// StartState is the name of the state the transition leaves.
+	StartState string
+
+	// Guard is the predicate the value must satisfy.
+	Guard P
+
+	// ResultState is the name of the state the transition enters.
+	ResultState string
+}
+
+// edge is a transition to the state with the target index.
+type edge[P any] struct {
+	guard  P
+	target int
+}
+
+// Automaton is a finite automation whose transitions are labelled with predicates of an Algebra
+// instead of single inputs. Guards of the same state may overlap, making it nondeterministic.
+type Automaton[P, D any] struct {
+	// algebra decides the guards.
+	algebra Algebra[P, D]
+
+	// names contains the state names, states are identified by their index.
+	names []string
+
+	// final describes which states are final states.
+	final []bool
+
+	// initial is the initial state.
+	initial int
+
+	// delta contains the transitions of every state.
+	delta [][]edge[P]
+}
+
+// New creates a new symbolic automation. Transitions with an unsatisfiable guard are dropped.
+func New[P, D any](algebra Algebra[P, D], Q []string, q0 string, F []string, Delta []Transition[P]) (*Automaton[P, D], error) {
+	a := &Automaton[P, D]{
+		algebra: algebra,
+		names:   slices.Clone(Q),
+		final:   make([]bool, len(Q)),
+		delta:   make([][]edge[P], len(Q)),
+	}
+
+	index := make(map[string]int, len(Q))
+	for i, q := range Q {
+		if _, ok := index[q]; !ok {
+			index[q] = i
+		}
+	}
+
+	find := func(name string) (int, error) {
+		i, ok := index[name]
+		if !ok {
+			return 0, fmt.Errorf("%w - %s", automaton.ErrStateNotFound, name)
+		}
+		return i, nil
+	}
+
+	initial, err := find(q0)
+	if err != nil {
+		return nil, fmt.Errorf("error setting initial state: %w", err)
+	}
+	a.initial = initial
+
+	for _, f := range F {
+		i, err := find(f)
+		if err != nil {
+			return nil, fmt.Errorf("error setting final states: %w", err)
+		}
+		a.final[i] = true
+	}
+
+	for _, d := range Delta {
+		start, err := find(d.StartState)
+		if err != nil {
+			return nil, fmt.Errorf("error setting transitions: %w", err)
+		}
+
+		result, err := find(d.ResultState)
+		if err != nil {
+			return nil, fmt.Errorf("error setting transitions: %w", err)
+		}
+
+		if algebra.IsSat(d.Guard) {
+			a.delta[start] = append(a.delta[start], edge[P]{guard: d.Guard, target: result})
+		}
+	}
+
+	return a, nil
+}
+
+// StateNames returns the names of all states.
+func (a *Automaton[P, D]) StateNames() []string {
+	return slices.Clone(a.names)
+}
+
+// InitialState returns the name of the initial state.
+func (a *Automaton[P, D]) InitialState() string {
+	return a.names[a.initial]
+}
+
+// FinalStates returns the names of the final states.
+func (a *Automaton[P, D]) FinalStates() []string {
+	names := make([]string, 0)
+	for i, final := range a.final {
+		if final {
+			names = append(names, a.names[i])
+		}
+	}
+
+	return names
+}
+
+// Transitions returns all transitions, ordered by state.
+func (a *Automaton[P, D]) Transitions() []Transition[P] {
+	transitions := make([]Transition[P], 0)
+	for i, edges := range a.delta {
+		for _, e := range edges {
+			transitions = append(transitions, Transition[P]{
+				StartState:  a.names[i],
+				Guard:       e.guard,
+				ResultState: a.names[e.target],
+			})
+		}
+	}
+
+	return transitions
+}
+
+// Accepts returns true if the automation accepts the values, following every transition whose guard holds.
+func (a *Automaton[P, D]) Accepts(values ...D) bool {
+	current := stateSet{a.initial}
+	for _, v := range values {
+		next := make(stateSet, 0)
+		for _, q := range current {
+			for _, e := range a.delta[q] {
+				if a.algebra.Contains(e.guard, v) {
+					next = append(next, e.target)
+				}
+			}
+		}
+
+		current = newStateSet(next)
+		if len(current) == 0 {
+			return false
+		}
+	}
+
+	return a.accepting(current)
+}
+
+// IsDeterministic returns true if no two transitions of the same state have overlapping guards.
+func (a *Automaton[P, D]) IsDeterministic() bool {
+	for _, edges := range a.delta {
+		for i := range edges {
+			for j := i + 1; j < len(edges); j++ {
+				if a.algebra.IsSat(a.algebra.And(edges[i].guard, edges[j].guard)) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// Determinize converts the automation to an equivalent deterministic one using the subset construction
+// over the minterms of the guards. Only subsets reachable from the initial state are created, and each
+// state is named after the states it contains, for example "{q0,q1}".
+func (a *Automaton[P, D]) Determinize() *Automaton[P, D] {
+	// Without limits and cancellation the construction can't fail.
+	result, _ := a.DeterminizeContext(context.Background(), automaton.Limits{})
+	return result
+}
+
+// DeterminizeContext is like Determinize, but stops when a limit is hit or the context is done.
+func (a *Automaton[P, D]) DeterminizeContext(ctx context.Context, limits automaton.Limits) (*Automaton[P, D], error) {
+	budget := automaton.NewBudget(ctx, limits)
+	result := &Automaton[P, D]{
+		algebra: a.algebra,
+		names:   make([]string, 0),
+		final:   make([]bool, 0),
+		delta:   make([][]edge[P], 0),
+	}
+
+	index := map[string]int{}
+	sets := make([]stateSet, 0)
+	add := func(set stateSet) (int, error) {
+		if i, ok := index[set.key()]; ok {
+			return i, nil
+		}
+
+		if err := budget.AddState(); err != nil {
+			return 0, fmt.Errorf("error determinizing symbolic automation: %w", err)
+		}
+
+		index[set.key()] = len(sets)
+		sets = append(sets, set)
+		result.names = append(result.names, a.subsetName(set))
+		result.final = append(result.final, a.accepting(set))
+		result.delta = append(result.delta, nil)
+		return len(sets) - 1, nil
+	}
+
+	if _, err := add(stateSet{a.initial}); err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(sets); i++ {
+		edges := make([]edge[P], 0)
+		for _, q := range sets[i] {
+			edges = append(edges, a.delta[q]...)
+		}
+
+		guards := make([]P, len(edges))
+		for j, e := range edges {
+			guards[j] = e.guard
+		}
+
+		// Minterms leading to the same subset are merged into a single transition.
+		targets := make(map[int]int)
+		for _, term := range minterms(a.algebra, guards) {
+			next := make(stateSet, 0)
+			for j, included := range term.included {
+				if included {
+					next = append(next, edges[j].target)
+				}
+			}
+			if len(next) == 0 {
+				continue
+			}
+
+			to, err := add(newStateSet(next))
+			if err != nil {
+				return nil, err
+			}
+
+			if k, ok := targets[to]; ok {
+				result.delta[i][k].guard = a.algebra.Or(result.delta[i][k].guard, term.predicate)
+				continue
+			}
+
+			if err := budget.AddTransition(); err != nil {
+				return nil, fmt.Errorf("error determinizing symbolic automation: %w", err)
+			}
+			targets[to] = len(result.delta[i])
+			result.delta[i] = append(result.delta[i], edge[P]{guard: term.predicate, target: to})
+		}
+	}
+
+	return result, nil
+}
+
+// Minimize returns the minimal deterministic automation accepting the same values, determinizing first
+// if needed. Equivalent states are merged using partition refinement, comparing the guards leading to
+// each block with Equivalent. Each state is named after the first state of its block, and states that
+// can't reach a final state are removed.
+func (a *Automaton[P, D]) Minimize() *Automaton[P, D] {
+	dfa := a
+	if !a.IsDeterministic() {
+		dfa = a.Determinize()
+	}
+	dfa = dfa.complete()
+
+	block := make([]int, len(dfa.names))
+	for i, final := range dfa.final {
+		if final {
+			block[i] = 1
+		}
+	}
+	count := renumber(block)
+
+	for {
+		next := make([]int, len(block))
+		representatives := make([]int, 0)
+		for q := range dfa.names {
+			next[q] = -1
+			for b, r := range representatives {
+				if block[r] == block[q] && dfa.equivalentGuards(q, r, block, count) {
+					next[q] = b
+					break
+				}
+			}
+			if next[q] < 0 {
+				next[q] = len(representatives)
+				representatives = append(representatives, q)
+			}
+		}
+
+		if len(representatives) == count {
+			break
+		}
+		block, count = next, len(representatives)
+	}
+
+	// Blocks are numbered by their first state, so the representative of a block is its first state.
+	representatives := make([]int, count)
+	for q := len(block) - 1; q >= 0; q-- {
+		representatives[block[q]] = q
+	}
+
+	result := &Automaton[P, D]{
+		algebra: dfa.algebra,
+		names:   make([]string, count),
+		final:   make([]bool, count),
+		initial: block[dfa.initial],
+		delta:   make([][]edge[P], count),
+	}
+	for b, q := range representatives {
+		result.names[b] = dfa.names[q]
+		result.final[b] = dfa.final[q]
+		guards := dfa.blockGuards(q, block, count)
+		for target, guard := range guards {
+			if dfa.algebra.IsSat(guard) {
+				result.delta[b] = append(result.delta[b], edge[P]{guard: guard, target: target})
+			}
+		}
+	}
+
+	return result.trim()
+}
+
+// Product returns an automation running a and b in lockstep, determinizing them first if needed. It accepts
+// values when accept returns true given whether a and b accept them. Only reachable pairs of states that
+// can still reach a final state are kept, and each state is named after its pair, for example "(q0,q1)".
+// Both automata must use the same algebra.
+func Product[P, D any](a, b *Automaton[P, D], accept func(acceptedByA, acceptedByB bool) bool) *Automaton[P, D] {
+	// Without limits and cancellation the construction can't fail.
+	result, _ := ProductContext(context.Background(), a, b, accept, automaton.Limits{})
+	return result
+}
+
+// ProductContext is like Product, but stops when a limit is hit or the context is done.
+func ProductContext[P, D any](ctx context.Context, a, b *Automaton[P, D], accept func(acceptedByA, acceptedByB bool) bool, limits automaton.Limits) (*Automaton[P, D], error) {
+	var err error
+	if !a.IsDeterministic() {
+		if a, err = a.DeterminizeContext(ctx, limits); err != nil {
+			return nil, err
+		}
+	}
+	if !b.IsDeterministic() {
+		if b, err = b.DeterminizeContext(ctx, limits); err != nil {
+			return nil, err
+		}
+	}
+	a, b = a.complete(), b.complete()
+
+	budget := automaton.NewBudget(ctx, limits)
+	result := &Automaton[P, D]{
+		algebra: a.algebra,
+		names:   make([]string, 0),
+		final:   make([]bool, 0),
+		delta:   make([][]edge[P], 0),
+	}
+
+	index := map[[2]int]int{}
+	pairs := make([][2]int, 0)
+	add := func(pair [2]int) (int, error) {
+		if i, ok := index[pair]; ok {
+			return i, nil
+		}
+
+		if err := budget.AddState(); err != nil {
+			return 0, fmt.Errorf("error building symbolic product: %w", err)
+		}
+
+		index[pair] = len(pairs)
+		pairs = append(pairs, pair)
+		result.names = append(result.names, fmt.Sprintf("(%s,%s)", a.names[pair[0]], b.names[pair[1]]))
+		result.final = append(result.final, accept(a.final[pair[0]], b.final[pair[1]]))
+		result.delta = append(result.delta, nil)
+		return len(pairs) - 1, nil
+	}
+
+	if _, err := add([2]int{a.initial, b.initial}); err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(pairs); i++ {
+		for _, ea := range a.delta[pairs[i][0]] {
+			for _, eb := range b.delta[pairs[i][1]] {
+				guard := a.algebra.And(ea.guard, eb.guard)
+				if !a.algebra.IsSat(guard) {
+					continue
+				}
+
+				to, err := add([2]int{ea.target, eb.target})
+				if err != nil {
+					return nil, err
+				}
+
+				if err := budget.AddTransition(); err != nil {
+					return nil, fmt.Errorf("error building symbolic product: %w", err)
+				}
+				result.delta[i] = append(result.delta[i], edge[P]{guard: guard, target: to})
+			}
+		}
+	}
+
+	return result.trim(), nil
+}
+
+// Intersection returns an automation accepting the values accepted by both a and b.
+func Intersection[P, D any](a, b *Automaton[P, D]) *Automaton[P, D] {
+	return Product(a, b, func(acceptedByA, acceptedByB bool) bool {
+		return acceptedByA && acceptedByB
+	})
+}
+
+// Union returns an automation accepting the values accepted by a or b.
+func Union[P, D any](a, b *Automaton[P, D]) *Automaton[P, D] {
+	return Product(a, b, func(acceptedByA, acceptedByB bool) bool {
+		return acceptedByA || acceptedByB
+	})
+}
+
+// complete returns the automation with a non-final sink state taking the values no guard of a state covers.
+// The automation is returned unchanged if every state already covers every value.
+func (a *Automaton[P, D]) complete() *Automaton[P, D] {
+	sink := len(a.names)
+	result := &Automaton[P, D]{
+		algebra: a.algebra,
+		names:   append(slices.Clone(a.names), a.freshName("sink")),
+		final:   append(slices.Clone(a.final), false),
+		initial: a.initial,
+		delta:   make([][]edge[P], len(a.names)+1),
+	}
+
+	needed := false
+	for q, edges := range a.delta {
+		covered := a.algebra.False()
+		for _, e := range edges {
+			covered = a.algebra.Or(covered, e.guard)
+		}
+
+		result.delta[q] = slices.Clone(edges)
+		if rest := a.algebra.Not(covered); a.algebra.IsSat(rest) {
+			result.delta[q] = append(result.delta[q], edge[P]{guard: rest, target: sink})
+			needed = true
+		}
+	}
+
+	if !needed {
+		return a
+	}
+	result.delta[sink] = []edge[P]{{guard: a.algebra.True(), target: sink}}
+
+	return result
+}
+
+// trim returns the automation without the states that aren't reachable or can't reach a final state,
+// and the transitions to them. The initial state is always kept.
+func (a *Automaton[P, D]) trim() *Automaton[P, D] {
+	reachable := make([]bool, len(a.names))
+	reachable[a.initial] = true
+	queue := []int{a.initial}
+	for i := 0; i < len(queue); i++ {
+		for _, e := range a.delta[queue[i]] {
+			if !reachable[e.target] {
+				reachable[e.target] = true
+				queue = append(queue, e.target)
+			}
+		}
+	}
+
+	useful := slices.Clone(a.final)
+	for changed := true; changed; {
+		changed = false
+		for q, edges := range a.delta {
+			for _, e := range edges {
+				if !useful[q] && useful[e.target] {
+					useful[q] = true
+					changed = true
+				}
+			}
+		}
+	}
+
+	index := make([]int, len(a.names))
+	result := &Automaton[P, D]{
+		algebra: a.algebra,
+		names:   make([]string, 0),
+		final:   make([]bool, 0),
+		delta:   make([][]edge[P], 0),
+	}
+	for q := range a.names {
+		index[q] = -1
+		if q == a.initial || (reachable[q] && useful[q]) {
+			index[q] = len(result.names)
+			result.names = append(result.names, a.names[q])
+			result.final = append(result.final, a.final[q])
+		}
+	}
+	result.initial = index[a.initial]
+
+	result.delta = make([][]edge[P], len(result.names))
+	for q, edges := range a.delta {
+		if index[q] < 0 {
+			continue
+		}
+		for _, e := range edges {
+			if index[e.target] >= 0 && useful[e.target] {
+				result.delta[index[q]] = append(result.delta[index[q]], edge[P]{guard: e.guard, target: index[e.target]})
+			}
+		}
+	}
+
+	return result
+}
+
+// blockGuards returns, for every block, the predicate of the values leading from the state to the block.
+func (a *Automaton[P, D]) blockGuards(q int, block []int, count int) []P {
+	guards := make([]P, count)
+	for b := range guards {
+		guards[b] = a.algebra.False()
+	}
+	for _, e := range a.delta[q] {
+		guards[block[e.target]] = a.algebra.Or(guards[block[e.target]], e.guard)
+	}
+
+	return guards
+}
+
+// equivalentGuards returns true if the same values lead the states p and q to the same blocks.
+func (a *Automaton[P, D]) equivalentGuards(p, q int, block []int, count int) bool {
+	guardsP, guardsQ := a.blockGuards(p, block, count), a.blockGuards(q, block, count)
+	for b := range guardsP {
+		if !Equivalent(a.algebra, guardsP[b], guardsQ[b]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// accepting returns true if the set contains a final state.
+func (a *Automaton[P, D]) accepting(set stateSet) bool {
+	for _, q := range set {
+		if a.final[q] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// subsetName returns the name of the deterministic state for the set.
+func (a *Automaton[P, D]) subsetName(set stateSet) string {
+	names := make([]string, len(set))
+	for i, q := range set {
+		names[i] = a.names[q]
+	}
+
+	return "{" + strings.Join(names, ",") + "}"
+}
+
+// freshName returns base, with a number appended if a state already has that name.
+func (a *Automaton[P, D]) freshName(base string) string {
+	name := base
+	for i := 1; slices.Contains(a.names, name); i++ {
+		name = base + strconv.Itoa(i)
+	}
+
+	return name
+}
+
+// renumber numbers the blocks in order of their first state and returns the number of blocks.
+func renumber(block []int) int {
+	numbers := make(map[int]int)
+	for q, b := range block {
+		if _, ok := numbers[b]; !ok {
+			numbers[b] = len(numbers)
+		}
+		block[q] = numbers[b]
+	}
+
+	return len(numbers)
+}
+
+// stateSet is a sorted set of state indexes.
+type stateSet []int
+
+// newStateSet sorts the states and removes duplicates.
+func newStateSet(states []int) stateSet {
+	slices.Sort(states)
+	return slices.Compact(states)
+}
+
+// key returns a string uniquely identifying the set, to be used as a map key.
+func (s stateSet) key() string {
+	var sb strings.Builder
+	for i, q := range s {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.Itoa(q))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,195 @@
+package symbolic_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/internal/automaton"
+	"github.com/amitprajapati027/finite-automation/symbolic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLargeTwice returns an automation accepting amounts with two consecutive amounts over 1000.
+func newLargeTwice(t *testing.T) *symbolic.Automaton[symbolic.Intervals, int64] {
+	t.Helper()
+
+	algebra := symbolic.IntervalAlgebra{}
+	a, err := symbolic.New[symbolic.Intervals, int64](algebra, []string{"q0", "q1", "q2"}, "q0", []string{"q2"}, []symbolic.Transition[symbolic.Intervals]{
+		{StartState: "q0", Guard: algebra.True(), ResultState: "q0"},
+		{StartState: "q0", Guard: symbolic.Greater(1000), ResultState: "q1"},
+		{StartState: "q1", Guard: symbolic.Greater(1000), ResultState: "q2"},
+		{StartState: "q2", Guard: algebra.True(), ResultState: "q2"},
+	})
+	require.NoError(t, err)
+
+	return a
+}
+
+// newRefund returns an automation accepting amounts containing a negative amount.
+func newRefund(t *testing.T) *symbolic.Automaton[symbolic.Intervals, int64] {
+	t.Helper()
+
+	algebra := symbolic.IntervalAlgebra{}
+	a, err := symbolic.New[symbolic.Intervals, int64](algebra, []string{"r0", "r1"}, "r0", []string{"r1"}, []symbolic.Transition[symbolic.Intervals]{
+		{StartState: "r0", Guard: symbolic.AtLeast(0), ResultState: "r0"},
+		{StartState: "r0", Guard: symbolic.Less(0), ResultState: "r1"},
+		{StartState: "r1", Guard: algebra.True(), ResultState: "r1"},
+	})
+	require.NoError(t, err)
+
+	return a
+}
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a := newLargeTwice(t)
+
+		assert.Equal(t, []string{"q0", "q1", "q2"}, a.StateNames())
+		assert.Equal(t, "q0", a.InitialState())
+		assert.Equal(t, []string{"q2"}, a.FinalStates())
+		assert.Len(t, a.Transitions(), 4)
+	})
+
+	t.Run("unsatisfiable guard", func(t *testing.T) {
+		algebra := symbolic.SetAlgebra[string]{}
+		a, err := symbolic.New[symbolic.Set[string], string](algebra, []string{"s"}, "s", []string{"s"}, []symbolic.Transition[symbolic.Set[string]]{
+			{StartState: "s", Guard: algebra.False(), ResultState: "s"},
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, a.Transitions())
+	})
+
+	t.Run("state not found", func(t *testing.T) {
+		algebra := symbolic.SetAlgebra[string]{}
+		_, err := symbolic.New[symbolic.Set[string], string](algebra, []string{"s"}, "s", nil, []symbolic.Transition[symbolic.Set[string]]{
+			{StartState: "s", Guard: algebra.True(), ResultState: "t"},
+		})
+
+		assert.ErrorIs(t, err, automaton.ErrStateNotFound)
+	})
+}
+
+func TestAutomaton_Accepts(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a := newLargeTwice(t)
+
+		assert.True(t, a.Accepts(5, 1500, 2000))
+		assert.True(t, a.Accepts(1001, 1001, -3))
+		assert.False(t, a.Accepts(1500, 1000, 2000))
+		assert.False(t, a.Accepts())
+	})
+}
+
+func TestAutomaton_Determinize(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a := newLargeTwice(t)
+		assert.False(t, a.IsDeterministic())
+
+		dfa := a.Determinize()
+		assert.True(t, dfa.IsDeterministic())
+		assert.Equal(t, []string{"{q0}", "{q0,q1}", "{q0,q1,q2}", "{q0,q2}"}, dfa.StateNames())
+		assert.Equal(t, []string{"{q0,q1,q2}", "{q0,q2}"}, dfa.FinalStates())
+		assert.Equal(t, []symbolic.Transition[symbolic.Intervals]{
+			{StartState: "{q0}", Guard: symbolic.Greater(1000), ResultState: "{q0,q1}"},
+			{StartState: "{q0}", Guard: symbolic.AtMost(1000), ResultState: "{q0}"},
+		}, dfa.Transitions()[:2])
+
+		for _, values := range [][]int64{{5, 1500, 2000}, {1500, 1000, 2000}, {2000, 2000, 0, 0}, {}} {
+			assert.Equal(t, a.Accepts(values...), dfa.Accepts(values...), values)
+		}
+	})
+}
+
+func TestAutomaton_DeterminizeContext(t *testing.T) {
+	t.Run("budget exceeded", func(t *testing.T) {
+		_, err := newLargeTwice(t).DeterminizeContext(context.Background(), automaton.Limits{MaxStates: 3})
+
+		var exceeded *automaton.BudgetExceededError
+		assert.ErrorAs(t, err, &exceeded)
+		assert.Equal(t, automaton.LimitStates, exceeded.Limit)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := newLargeTwice(t).DeterminizeContext(ctx, automaton.Limits{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestAutomaton_Minimize(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a := newLargeTwice(t)
+
+		minimal := a.Minimize()
+		assert.True(t, minimal.IsDeterministic())
+		assert.Equal(t, []string{"{q0}", "{q0,q1}", "{q0,q1,q2}"}, minimal.StateNames())
+		assert.Equal(t, []symbolic.Transition[symbolic.Intervals]{
+			{StartState: "{q0,q1,q2}", Guard: symbolic.IntervalAlgebra{}.True(), ResultState: "{q0,q1,q2}"},
+		}, minimal.Transitions()[4:])
+
+		for _, values := range [][]int64{{5, 1500, 2000}, {1500, 1000, 2000}, {2000, 2000, 0, 0}, {}} {
+			assert.Equal(t, a.Accepts(values...), minimal.Accepts(values...), values)
+		}
+	})
+
+	t.Run("empty language", func(t *testing.T) {
+		algebra := symbolic.SetAlgebra[string]{}
+		a, err := symbolic.New[symbolic.Set[string], string](algebra, []string{"s", "t"}, "s", nil, []symbolic.Transition[symbolic.Set[string]]{
+			{StartState: "s", Guard: symbolic.Of("EUR"), ResultState: "t"},
+		})
+		require.NoError(t, err)
+
+		minimal := a.Minimize()
+		assert.Equal(t, []string{"s"}, minimal.StateNames())
+		assert.Empty(t, minimal.Transitions())
+	})
+}
+
+func TestProduct(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Two consecutive large amounts without any refund.
+		a := symbolic.Product(newLargeTwice(t), newRefund(t), func(acceptedByA, acceptedByB bool) bool {
+			return acceptedByA && !acceptedByB
+		})
+
+		assert.Equal(t, "({q0},r0)", a.InitialState())
+		assert.True(t, a.Accepts(1500, 2000))
+		assert.False(t, a.Accepts(1500, 2000, -5))
+		assert.False(t, a.Accepts(1500, 5))
+	})
+}
+
+func TestProductContext(t *testing.T) {
+	t.Run("budget exceeded", func(t *testing.T) {
+		_, err := symbolic.ProductContext(context.Background(), newLargeTwice(t), newRefund(t), func(acceptedByA, acceptedByB bool) bool {
+			return acceptedByA && acceptedByB
+		}, automaton.Limits{MaxStates: 4})
+
+		assert.ErrorIs(t, err, automaton.ErrBudgetExceeded)
+	})
+}
+
+func TestIntersection(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a := symbolic.Intersection(newLargeTwice(t), newRefund(t))
+
+		assert.True(t, a.Accepts(-1, 1500, 2000))
+		assert.True(t, a.Accepts(1500, 2000, -1))
+		assert.False(t, a.Accepts(1500, 2000))
+		assert.False(t, a.Accepts(-1, 1500))
+	})
+}
+
+func TestUnion(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		a := symbolic.Union(newLargeTwice(t), newRefund(t))
+
+		assert.True(t, a.Accepts(-1))
+		assert.True(t, a.Accepts(1500, 2000))
+		assert.False(t, a.Accepts(1500, 5))
+	})
+}
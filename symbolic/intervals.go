@@ -0,0 +1,181 @@
+package symbolic
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Interval is an inclusive range of integers.
+type Interval struct {
+	// Lo is the least value, math.MinInt64 for no lower bound.
+	Lo int64
+
+	// Hi is the greatest value, math.MaxInt64 for no upper bound.
+	Hi int64
+}
+
+// Intervals is a predicate over integers, stored as sorted, disjoint and non-adjacent intervals.
+type Intervals []Interval
+
+// NewIntervals returns the integers in the intervals. Intervals may overlap, empty intervals are ignored.
+func NewIntervals(intervals ...Interval) Intervals {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, i := range intervals {
+		if i.Lo <= i.Hi {
+			sorted = append(sorted, i)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Interval) int {
+		switch {
+		case a.Lo < b.Lo:
+			return -1
+		case a.Lo > b.Lo:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	merged := make(Intervals, 0, len(sorted))
+	for _, i := range sorted {
+		if n := len(merged); n > 0 && (merged[n-1].Hi == math.MaxInt64 || i.Lo <= merged[n-1].Hi+1) {
+			merged[n-1].Hi = max(merged[n-1].Hi, i.Hi)
+			continue
+		}
+		merged = append(merged, i)
+	}
+
+	return merged
+}
+
+// Between returns the integers from lo to hi, both included.
+func Between(lo, hi int64) Intervals {
+	return NewIntervals(Interval{Lo: lo, Hi: hi})
+}
+
+// AtLeast returns the integers greater than or equal to lo.
+func AtLeast(lo int64) Intervals {
+	return Between(lo, math.MaxInt64)
+}
+
+// AtMost returns the integers less than or equal to hi.
+func AtMost(hi int64) Intervals {
+	return Between(math.MinInt64, hi)
+}
+
+// Greater returns the integers greater than x.
+func Greater(x int64) Intervals {
+	if x == math.MaxInt64 {
+		return Intervals{}
+	}
+
+	return AtLeast(x + 1)
+}
+
+// Less returns the integers less than x.
+func Less(x int64) Intervals {
+	if x == math.MinInt64 {
+		return Intervals{}
+	}
+
+	return AtMost(x - 1)
+}
+
+// Equal returns the integer x.
+func Equal(x int64) Intervals {
+	return Between(x, x)
+}
+
+// String returns the intervals, for example [-inf,0] | [1001,+inf].
+func (s Intervals) String() string {
+	if len(s) == 0 {
+		return "false"
+	}
+
+	parts := make([]string, len(s))
+	for i, interval := range s {
+		lo, hi := fmt.Sprint(interval.Lo), fmt.Sprint(interval.Hi)
+		if interval.Lo == math.MinInt64 {
+			lo = "-inf"
+		}
+		if interval.Hi == math.MaxInt64 {
+			hi = "+inf"
+		}
+		parts[i] = fmt.Sprintf("[%s,%s]", lo, hi)
+	}
+
+	return strings.Join(parts, " | ")
+}
+
+// IntervalAlgebra is the Boolean algebra of Intervals over int64 values.
+type IntervalAlgebra struct{}
+
+// True returns every integer.
+func (IntervalAlgebra) True() Intervals {
+	return Between(math.MinInt64, math.MaxInt64)
+}
+
+// False returns no integer.
+func (IntervalAlgebra) False() Intervals {
+	return Intervals{}
+}
+
+// And returns the intersection of the intervals.
+func (IntervalAlgebra) And(a, b Intervals) Intervals {
+	result := make(Intervals, 0)
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		lo, hi := max(a[i].Lo, b[j].Lo), min(a[i].Hi, b[j].Hi)
+		if lo <= hi {
+			result = append(result, Interval{Lo: lo, Hi: hi})
+		}
+
+		if a[i].Hi < b[j].Hi {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return result
+}
+
+// Or returns the union of the intervals.
+func (IntervalAlgebra) Or(a, b Intervals) Intervals {
+	return NewIntervals(append(slices.Clone(a), b...)...)
+}
+
+// Not returns the integers outside of the intervals.
+func (IntervalAlgebra) Not(p Intervals) Intervals {
+	result := make(Intervals, 0, len(p)+1)
+	lo := int64(math.MinInt64)
+	for _, interval := range p {
+		if interval.Lo > lo {
+			result = append(result, Interval{Lo: lo, Hi: interval.Lo - 1})
+		}
+		if interval.Hi == math.MaxInt64 {
+			return result
+		}
+		lo = interval.Hi + 1
+	}
+
+	return append(result, Interval{Lo: lo, Hi: math.MaxInt64})
+}
+
+// IsSat returns true if the intervals contain an integer.
+func (IntervalAlgebra) IsSat(p Intervals) bool {
+	return len(p) > 0
+}
+
+// Contains returns true if the intervals contain the value.
+func (IntervalAlgebra) Contains(p Intervals, value int64) bool {
+	for _, interval := range p {
+		if interval.Lo <= value && value <= interval.Hi {
+			return true
+		}
+	}
+
+	return false
+}
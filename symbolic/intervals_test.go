@@ -0,0 +1,77 @@
+package symbolic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/symbolic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIntervals(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		intervals := symbolic.NewIntervals(
+			symbolic.Interval{Lo: 10, Hi: 20},
+			symbolic.Interval{Lo: 1, Hi: 5},
+			symbolic.Interval{Lo: 6, Hi: 8},
+			symbolic.Interval{Lo: 15, Hi: 30},
+			symbolic.Interval{Lo: 3, Hi: 2},
+		)
+
+		assert.Equal(t, symbolic.Intervals{{Lo: 1, Hi: 8}, {Lo: 10, Hi: 30}}, intervals)
+	})
+
+	t.Run("unbounded", func(t *testing.T) {
+		intervals := symbolic.NewIntervals(
+			symbolic.Interval{Lo: 0, Hi: math.MaxInt64},
+			symbolic.Interval{Lo: 5, Hi: math.MaxInt64},
+		)
+
+		assert.Equal(t, symbolic.AtLeast(0), intervals)
+	})
+}
+
+func TestIntervals_String(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, "[-inf,-1] | [1001,+inf]", symbolic.NewIntervals(symbolic.Less(0)[0], symbolic.Greater(1000)[0]).String())
+		assert.Equal(t, "[5,5]", symbolic.Equal(5).String())
+		assert.Equal(t, "false", symbolic.Intervals{}.String())
+	})
+}
+
+func TestGreater(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, symbolic.AtLeast(1001), symbolic.Greater(1000))
+		assert.Empty(t, symbolic.Greater(math.MaxInt64))
+		assert.Equal(t, symbolic.AtMost(999), symbolic.Less(1000))
+		assert.Empty(t, symbolic.Less(math.MinInt64))
+	})
+}
+
+func TestIntervalAlgebra(t *testing.T) {
+	algebra := symbolic.IntervalAlgebra{}
+
+	t.Run("and", func(t *testing.T) {
+		a := symbolic.NewIntervals(symbolic.Interval{Lo: 0, Hi: 10}, symbolic.Interval{Lo: 20, Hi: 30})
+
+		assert.Equal(t, symbolic.NewIntervals(symbolic.Interval{Lo: 5, Hi: 10}, symbolic.Interval{Lo: 20, Hi: 25}), algebra.And(a, symbolic.Between(5, 25)))
+		assert.False(t, algebra.IsSat(algebra.And(symbolic.Less(0), symbolic.Greater(0))))
+	})
+
+	t.Run("or", func(t *testing.T) {
+		assert.Equal(t, symbolic.Between(0, 20), algebra.Or(symbolic.Between(0, 10), symbolic.Between(11, 20)))
+	})
+
+	t.Run("not", func(t *testing.T) {
+		assert.Equal(t, symbolic.NewIntervals(symbolic.Less(0)[0], symbolic.Greater(10)[0]), algebra.Not(symbolic.Between(0, 10)))
+		assert.Equal(t, algebra.True(), algebra.Not(algebra.False()))
+		assert.Equal(t, algebra.False(), algebra.Not(algebra.True()))
+		assert.Equal(t, symbolic.Less(1000), algebra.Not(symbolic.AtLeast(1000)))
+	})
+
+	t.Run("contains", func(t *testing.T) {
+		assert.True(t, algebra.Contains(symbolic.Greater(1000), 1001))
+		assert.False(t, algebra.Contains(symbolic.Greater(1000), 1000))
+		assert.True(t, algebra.Contains(algebra.True(), math.MinInt64))
+	})
+}
@@ -0,0 +1,111 @@
+package symbolic
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Set is a predicate over comparable values: a finite set of values, or the complement of one.
+type Set[T comparable] struct {
+	// elements contains the listed values.
+	elements map[T]bool
+
+	// complement is true if the set contains every value except the listed ones.
+	complement bool
+}
+
+// Of returns the set of the values.
+func Of[T comparable](values ...T) Set[T] {
+	elements := make(map[T]bool, len(values))
+	for _, v := range values {
+		elements[v] = true
+	}
+
+	return Set[T]{elements: elements}
+}
+
+// String returns the listed values in sorted order, for example {a, b} or not {a, b}.
+func (s Set[T]) String() string {
+	values := make([]string, 0, len(s.elements))
+	for v := range s.elements {
+		values = append(values, fmt.Sprint(v))
+	}
+	slices.Sort(values)
+
+	listed := "{" + strings.Join(values, ", ") + "}"
+	if s.complement {
+		return "not " + listed
+	}
+
+	return listed
+}
+
+// SetAlgebra is the Boolean algebra of finite and cofinite Sets.
+// The domain is assumed to be infinite, so the complement of a finite set is always satisfiable.
+type SetAlgebra[T comparable] struct{}
+
+// True returns the set of every value.
+func (SetAlgebra[T]) True() Set[T] {
+	return Set[T]{elements: map[T]bool{}, complement: true}
+}
+
+// False returns the empty set.
+func (SetAlgebra[T]) False() Set[T] {
+	return Set[T]{elements: map[T]bool{}}
+}
+
+// And returns the intersection of the sets.
+func (SetAlgebra[T]) And(a, b Set[T]) Set[T] {
+	switch {
+	case !a.complement && !b.complement:
+		return Set[T]{elements: filter(a.elements, func(v T) bool { return b.elements[v] })}
+	case !a.complement:
+		return Set[T]{elements: filter(a.elements, func(v T) bool { return !b.elements[v] })}
+	case !b.complement:
+		return Set[T]{elements: filter(b.elements, func(v T) bool { return !a.elements[v] })}
+	default:
+		return Set[T]{elements: union(a.elements, b.elements), complement: true}
+	}
+}
+
+// Or returns the union of the sets.
+func (algebra SetAlgebra[T]) Or(a, b Set[T]) Set[T] {
+	return algebra.Not(algebra.And(algebra.Not(a), algebra.Not(b)))
+}
+
+// Not returns the complement of the set.
+func (SetAlgebra[T]) Not(p Set[T]) Set[T] {
+	return Set[T]{elements: maps.Clone(p.elements), complement: !p.complement}
+}
+
+// IsSat returns true if the set contains a value.
+func (SetAlgebra[T]) IsSat(p Set[T]) bool {
+	return p.complement || len(p.elements) > 0
+}
+
+// Contains returns true if the set contains the value.
+func (SetAlgebra[T]) Contains(p Set[T], value T) bool {
+	return p.elements[value] != p.complement
+}
+
+// filter returns the elements for which keep returns true.
+func filter[T comparable](elements map[T]bool, keep func(T) bool) map[T]bool {
+	result := make(map[T]bool)
+	for v := range elements {
+		if keep(v) {
+			result[v] = true
+		}
+	}
+
+	return result
+}
+
+// union returns the elements of a and b.
+func union[T comparable](a, b map[T]bool) map[T]bool {
+	result := maps.Clone(a)
+	maps.Copy(result, b)
+
+	return result
+}
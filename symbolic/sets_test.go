@@ -0,0 +1,45 @@
+package symbolic_test
+
+import (
+	"testing"
+
+	"github.com/amitprajapati027/finite-automation/symbolic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet_String(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		algebra := symbolic.SetAlgebra[string]{}
+
+		assert.Equal(t, "{EUR, USD}", symbolic.Of("USD", "EUR").String())
+		assert.Equal(t, "not {EUR}", algebra.Not(symbolic.Of("EUR")).String())
+		assert.Equal(t, "{}", algebra.False().String())
+	})
+}
+
+func TestSetAlgebra(t *testing.T) {
+	algebra := symbolic.SetAlgebra[string]{}
+	eur, usd := symbolic.Of("EUR"), symbolic.Of("USD")
+	both := symbolic.Of("EUR", "USD")
+
+	t.Run("and", func(t *testing.T) {
+		assert.Equal(t, eur, algebra.And(both, eur))
+		assert.Equal(t, usd, algebra.And(both, algebra.Not(eur)))
+		assert.Equal(t, usd, algebra.And(algebra.Not(eur), both))
+		assert.Equal(t, algebra.Not(both), algebra.And(algebra.Not(eur), algebra.Not(usd)))
+		assert.False(t, algebra.IsSat(algebra.And(eur, usd)))
+	})
+
+	t.Run("or", func(t *testing.T) {
+		assert.Equal(t, both, algebra.Or(eur, usd))
+		assert.Equal(t, algebra.Not(usd), algebra.Or(eur, algebra.Not(both)))
+		assert.True(t, symbolic.Equivalent[symbolic.Set[string], string](algebra, algebra.True(), algebra.Or(eur, algebra.Not(eur))))
+	})
+
+	t.Run("contains", func(t *testing.T) {
+		assert.True(t, algebra.Contains(both, "EUR"))
+		assert.False(t, algebra.Contains(both, "GBP"))
+		assert.True(t, algebra.Contains(algebra.Not(both), "GBP"))
+		assert.False(t, algebra.Contains(algebra.False(), "EUR"))
+	})
+}
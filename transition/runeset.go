@@ -0,0 +1,167 @@
+package transition
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// RuneRange is an inclusive range of runes.
+type RuneRange struct {
+	// Lo is the first rune of the range.
+	Lo rune `json:"lo"`
+
+	// Hi is the last rune of the range.
+	Hi rune `json:"hi"`
+}
+
+// RuneSet is a set of runes stored as sorted, disjoint and non-adjacent ranges.
+// Use NewRuneSet to create one from arbitrary ranges.
+type RuneSet []RuneRange
+
+// NewRuneSet returns the set of runes in the ranges. Ranges may overlap, empty ranges are ignored.
+func NewRuneSet(ranges ...RuneRange) RuneSet {
+	sorted := make([]RuneRange, 0, len(ranges))
+	for _, r := range ranges {
+		if r.Lo <= r.Hi {
+			sorted = append(sorted, r)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b RuneRange) int { return int(a.Lo) - int(b.Lo) })
+
+	set := make(RuneSet, 0, len(sorted))
+	for _, r := range sorted {
+		if n := len(set); n > 0 && r.Lo <= set[n-1].Hi+1 {
+			set[n-1].Hi = max(set[n-1].Hi, r.Hi)
+			continue
+		}
+		set = append(set, r)
+	}
+
+	return set
+}
+
+// Range returns the set of runes from lo to hi, both included.
+func Range(lo, hi rune) RuneSet {
+	return NewRuneSet(RuneRange{Lo: lo, Hi: hi})
+}
+
+// Runes returns the set of the runes.
+func Runes(runes ...rune) RuneSet {
+	ranges := make([]RuneRange, len(runes))
+	for i, r := range runes {
+		ranges[i] = RuneRange{Lo: r, Hi: r}
+	}
+
+	return NewRuneSet(ranges...)
+}
+
+// RuneSetOf returns the set of runes in the Unicode tables, for example unicode.Letter.
+func RuneSetOf(tables ...*unicode.RangeTable) RuneSet {
+	ranges := make([]RuneRange, 0)
+	add := func(lo, hi, stride rune) {
+		if stride == 1 {
+			ranges = append(ranges, RuneRange{Lo: lo, Hi: hi})
+			return
+		}
+		for r := lo; r <= hi; r += stride {
+			ranges = append(ranges, RuneRange{Lo: r, Hi: r})
+		}
+	}
+
+	for _, table := range tables {
+		for _, r := range table.R16 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+		for _, r := range table.R32 {
+			add(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+		}
+	}
+
+	return NewRuneSet(ranges...)
+}
+
+// Contains returns true if the set contains the rune.
+func (s RuneSet) Contains(r rune) bool {
+	_, found := slices.BinarySearchFunc(s, r, func(rr RuneRange, r rune) int {
+		switch {
+		case rr.Hi < r:
+			return -1
+		case rr.Lo > r:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return found
+}
+
+// IsEmpty returns true if the set contains no rune.
+// Sets not created with NewRuneSet may contain empty ranges, such as {'z', 'a'}.
+func (s RuneSet) IsEmpty() bool {
+	for _, r := range s {
+		if r.Lo <= r.Hi {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Union returns the runes in s or other.
+func (s RuneSet) Union(other RuneSet) RuneSet {
+	return NewRuneSet(append(slices.Clone(s), other...)...)
+}
+
+// Subtract returns the runes in s but not in other.
+func (s RuneSet) Subtract(other RuneSet) RuneSet {
+	result := make(RuneSet, 0, len(s))
+	for _, r := range s {
+		lo := r.Lo
+		for _, o := range other {
+			if o.Hi < lo || o.Lo > r.Hi {
+				continue
+			}
+			if o.Lo > lo {
+				result = append(result, RuneRange{Lo: lo, Hi: o.Lo - 1})
+			}
+			lo = o.Hi + 1
+		}
+		if lo <= r.Hi {
+			result = append(result, RuneRange{Lo: lo, Hi: r.Hi})
+		}
+	}
+
+	return result
+}
+
+// String returns the set as a character class, for example [0-9a-z].
+func (s RuneSet) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for _, r := range s {
+		sb.WriteString(quote(r.Lo))
+		if r.Hi > r.Lo {
+			sb.WriteString("-")
+			sb.WriteString(quote(r.Hi))
+		}
+	}
+	sb.WriteString("]")
+
+	return sb.String()
+}
+
+// quote returns the rune, escaped if it's not printable or has a special meaning in a class.
+func quote(r rune) string {
+	switch {
+	case strings.ContainsRune(`\[]^-`, r):
+		return `\` + string(r)
+	case !unicode.IsPrint(r):
+		quoted := strconv.QuoteRuneToASCII(r)
+		return quoted[1 : len(quoted)-1]
+	}
+
+	return string(r)
+}
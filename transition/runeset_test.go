@@ -0,0 +1,107 @@
+package transition_test
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/amitprajapati027/finite-automation/transition"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRuneSet(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		set := transition.NewRuneSet(
+			transition.RuneRange{Lo: 'x', Hi: 'z'},
+			transition.RuneRange{Lo: 'a', Hi: 'f'},
+			transition.RuneRange{Lo: 'c', Hi: 'k'},
+			transition.RuneRange{Lo: 'l', Hi: 'm'},
+			transition.RuneRange{Lo: 'q', Hi: 'p'},
+		)
+
+		assert.Equal(t, transition.RuneSet{{Lo: 'a', Hi: 'm'}, {Lo: 'x', Hi: 'z'}}, set)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.True(t, transition.NewRuneSet().IsEmpty())
+		assert.False(t, transition.Range('a', 'a').IsEmpty())
+	})
+}
+
+func TestRunes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, transition.RuneSet{{Lo: '-', Hi: '-'}, {Lo: '_', Hi: '_'}}, transition.Runes('_', '-', '_'))
+	})
+}
+
+func TestRuneSetOf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		set := transition.RuneSetOf(unicode.Letter, unicode.Digit)
+
+		assert.True(t, set.Contains('a'))
+		assert.True(t, set.Contains('é'))
+		assert.True(t, set.Contains('٣'))
+		assert.False(t, set.Contains('_'))
+		assert.False(t, set.Contains(' '))
+	})
+
+	t.Run("stride", func(t *testing.T) {
+		table := &unicode.RangeTable{R16: []unicode.Range16{{Lo: 'a', Hi: 'e', Stride: 2}}}
+
+		assert.Equal(t, "[ace]", transition.RuneSetOf(table).String())
+	})
+}
+
+func TestRuneSet_Contains(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		set := transition.NewRuneSet(transition.RuneRange{Lo: '0', Hi: '9'}, transition.RuneRange{Lo: 'a', Hi: 'f'})
+
+		for _, r := range "09af5c" {
+			assert.True(t, set.Contains(r), string(r))
+		}
+		for _, r := range "/:`gA" {
+			assert.False(t, set.Contains(r), string(r))
+		}
+		assert.False(t, transition.RuneSet{}.Contains('a'))
+	})
+}
+
+func TestRuneSet_IsEmpty(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.False(t, transition.Range('a', 'z').IsEmpty())
+		assert.True(t, transition.RuneSet{}.IsEmpty())
+	})
+
+	t.Run("unnormalized", func(t *testing.T) {
+		assert.True(t, transition.RuneSet{{Lo: 'z', Hi: 'a'}}.IsEmpty())
+		assert.False(t, transition.RuneSet{{Lo: 'z', Hi: 'a'}, {Lo: '0', Hi: '9'}}.IsEmpty())
+	})
+}
+
+func TestRuneSet_Union(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		set := transition.Range('a', 'f').Union(transition.Range('d', 'k'))
+
+		assert.Equal(t, transition.Range('a', 'k'), set)
+	})
+}
+
+func TestRuneSet_Subtract(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		set := transition.Range('a', 'z').Subtract(transition.Runes('a', 'm', 'n', 'z'))
+
+		assert.Equal(t, "[b-lo-y]", set.String())
+	})
+
+	t.Run("disjoint", func(t *testing.T) {
+		assert.Equal(t, transition.Range('a', 'c'), transition.Range('a', 'c').Subtract(transition.Range('x', 'z')))
+		assert.Empty(t, transition.Range('b', 'c').Subtract(transition.Range('a', 'z')))
+	})
+}
+
+func TestRuneSet_String(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, "[0-9a-z]", transition.Range('a', 'z').Union(transition.Range('0', '9')).String())
+		assert.Equal(t, `[\n\-\]]`, transition.Runes('-', ']', '\n').String())
+		assert.Equal(t, "[]", transition.RuneSet{}.String())
+	})
+}
@@ -4,12 +4,12 @@ package transition
 type Transition struct {
 	// StartState is the state from which the transition starts.
 	// If two transitions have same StartState and Input,
-	// the newer transition is used.
+	// the newer transition is used, unless the builder is strict.
 	StartState string
 
 	// Input contains the input for transitions.
 	// If two transitions have same StartState and Input,
-	// the newer transition is used.
+	// the newer transition is used, unless the builder is strict.
 	Input string
 
 	// ResultState is the state that the input transtions the FSA into.
@@ -34,3 +34,20 @@ func (ts Transitions) GetInputs() []string {
 
 	return inputs
 }
+
+// RangeTransition holds the properties of a transition taken for any rune of a set.
+// Transitions for a single input take precedence over range transitions.
+type RangeTransition struct {
+	// StartState is the state from which the transition starts.
+	// If two range transitions from the same StartState overlap, the newer transition is used.
+	StartState string `json:"startState"`
+
+	// Runes contains the runes the transition is taken for.
+	Runes RuneSet `json:"runes"`
+
+	// ResultState is the state that the runes transition the FSA into.
+	ResultState string `json:"resultState"`
+}
+
+// RangeTransitions is a collection of range transitions.
+type RangeTransitions []RangeTransition
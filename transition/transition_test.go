@@ -1,6 +1,7 @@
 package transition_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/amitprajapati027/finite-automation/transition"
@@ -27,3 +28,11 @@ func TestTransitions_GetInputs(t *testing.T) {
 		assert.Equal(t, []string{"1", "0"}, ts.GetInputs())
 	})
 }
+
+func TestTransition_JSON(t *testing.T) {
+	t.Run("field names", func(t *testing.T) {
+		data, err := json.Marshal(transition.Transition{StartState: "s1", Input: "1", ResultState: "s2"})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"StartState": "s1", "Input": "1", "ResultState": "s2"}`, string(data))
+	})
+}